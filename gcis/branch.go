@@ -0,0 +1,63 @@
+package gcis
+
+import "context"
+
+type BranchService service
+
+type BranchBasicInformationInput struct {
+	BranchOfficeBusinessAccountingNO string
+}
+
+type BranchBasicInformationOutput struct {
+	BranchOfficeBusinessAccountingNO string `json:"Branch_Office_Business_Accounting_NO" xml:"Branch_Office_Business_Accounting_NO"`
+	BranchOfficeName                 string `json:"Branch_Office_Name" xml:"Branch_Office_Name"`
+	BranchOfficeStatus               string `json:"Branch_Office_Status" xml:"Branch_Office_Status"`
+	BranchOfficeStatusDesc           string `json:"Branch_Office_Status_Desc" xml:"Branch_Office_Status_Desc"`
+	BranchOfficeLocation             string `json:"Branch_Office_Location" xml:"Branch_Office_Location"`
+	BranchOfficeManagerName          string `json:"Branch_Office_Manager_Name" xml:"Branch_Office_Manager_Name"`
+	BranchOfficeSetupApproveDate     string `json:"Branch_Office_Setup_Approve_Date" xml:"Branch_Office_Setup_Approve_Date"`
+	BranchOfficeLastChangeDate       string `json:"Branch_Office_Last_Change_Date" xml:"Branch_Office_Last_Change_Date"`
+	BusinessAccountingNO             string `json:"Business_Accounting_NO" xml:"Business_Accounting_NO"`
+	CompanyName                      string `json:"Company_Name" xml:"Company_Name"`
+}
+
+// GetBasicInformation fetches the basic information of branch office by its own accounting no.
+func (s *BranchService) GetBasicInformation(ctx context.Context, input *BranchBasicInformationInput) (*BranchBasicInformationOutput, *Response, error) {
+	q := &Query{Filter: Eq("Branch_Office_Business_Accounting_NO", input.BranchOfficeBusinessAccountingNO)}
+	outputs := []BranchBasicInformationOutput{}
+
+	resp, err := s.client.Query(ctx, "FCB90AB1-E382-45CE-8D4F-394861851E28", q, &outputs)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(outputs) == 0 {
+		return nil, resp, nil
+	}
+	return &outputs[0], resp, nil
+}
+
+type BranchByCompanyInput struct {
+	BusinessAccountingNO string
+
+	SearchOptions
+}
+
+// ListByCompany lists the branch offices of company by accounting no.
+func (s *BranchService) ListByCompany(ctx context.Context, input *BranchByCompanyInput) ([]BranchBasicInformationOutput, *Response, error) {
+	opts, err := input.SearchOptions.withDefaults()
+	if err != nil {
+		return nil, nil, err
+	}
+	q := &Query{
+		Filter: Eq("Business_Accounting_NO", input.BusinessAccountingNO),
+		Skip:   opts.Skip,
+		Top:    opts.Top,
+	}
+	outputs := []BranchBasicInformationOutput{}
+
+	resp, err := s.client.Query(ctx, "FCB90AB1-E382-45CE-8D4F-394861851E28", q, &outputs)
+	if err != nil {
+		return nil, resp, err
+	}
+	return outputs, resp, nil
+}
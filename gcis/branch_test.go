@@ -0,0 +1,110 @@
+package gcis
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+var (
+	branchBasicInformationJSON = []byte(`[
+  {
+    "Branch_Office_Business_Accounting_NO": "84149961",
+    "Branch_Office_Name": "宏碁股份有限公司台中分公司",
+    "Branch_Office_Status": "01",
+    "Branch_Office_Status_Desc": "核准設立",
+    "Branch_Office_Location": "臺中市西屯區臺灣大道三段301號",
+    "Branch_Office_Manager_Name": "王O明",
+    "Branch_Office_Setup_Approve_Date": "0921015",
+    "Branch_Office_Last_Change_Date": "1050412",
+    "Business_Accounting_NO": "20828393",
+    "Company_Name": "宏碁股份有限公司"
+  }
+]`)
+
+	branchBasicInformation = BranchBasicInformationOutput{
+		BranchOfficeBusinessAccountingNO: "84149961",
+		BranchOfficeName:                 "宏碁股份有限公司台中分公司",
+		BranchOfficeStatus:               "01",
+		BranchOfficeStatusDesc:           "核准設立",
+		BranchOfficeLocation:             "臺中市西屯區臺灣大道三段301號",
+		BranchOfficeManagerName:          "王O明",
+		BranchOfficeSetupApproveDate:     "0921015",
+		BranchOfficeLastChangeDate:       "1050412",
+		BusinessAccountingNO:             "20828393",
+		CompanyName:                      "宏碁股份有限公司",
+	}
+)
+
+func TestBranchService_GetBasicInformation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handle(t, "/od/data/api/FCB90AB1-E382-45CE-8D4F-394861851E28", branchBasicInformationJSON)
+
+	got, _, err := client.Branch.GetBasicInformation(context.Background(), &BranchBasicInformationInput{"84149961"})
+	if err != nil {
+		t.Errorf("Branch.GetBasicInformation returned error: %v", err)
+	}
+	if want := &branchBasicInformation; !reflect.DeepEqual(got, want) {
+		t.Errorf("Branch.GetBasicInformation = %+v, want %+v", got, want)
+	}
+}
+
+func TestBranchService_GetBasicInformation_notFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handle(t, "/od/data/api/FCB90AB1-E382-45CE-8D4F-394861851E28", nil)
+
+	got, _, err := client.Branch.GetBasicInformation(context.Background(), &BranchBasicInformationInput{})
+	if err != nil {
+		t.Errorf("Branch.GetBasicInformation returned error: %v", err)
+	}
+	if got != nil {
+		t.Errorf("Branch.GetBasicInformation = %+v, want nil", got)
+	}
+}
+
+func TestBranchService_ListByCompany(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/od/data/api/FCB90AB1-E382-45CE-8D4F-394861851E28", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, "$filter", "Business_Accounting_NO eq '20828393'")
+		testQuery(t, r, "$skip", "10")
+		testQuery(t, r, "$top", "5")
+
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		w.Write(branchBasicInformationJSON)
+	})
+
+	got, _, err := client.Branch.ListByCompany(context.Background(),
+		&BranchByCompanyInput{
+			BusinessAccountingNO: "20828393",
+			SearchOptions:        SearchOptions{Skip: 10, Top: 5},
+		})
+	if err != nil {
+		t.Errorf("Branch.ListByCompany returned error: %v", err)
+	}
+	if want := []BranchBasicInformationOutput{branchBasicInformation}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Branch.ListByCompany = %+v, want %+v", got, want)
+	}
+}
+
+func TestBranchService_ListByCompany_emptyChunked(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handleChunked(t, "/od/data/api/FCB90AB1-E382-45CE-8D4F-394861851E28", nil)
+
+	got, _, err := client.Branch.ListByCompany(context.Background(), &BranchByCompanyInput{})
+	if err != nil {
+		t.Errorf("Branch.ListByCompany returned error: %v", err)
+	}
+	if want := []BranchBasicInformationOutput{}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Branch.ListByCompany = %+v, want %+v", got, want)
+	}
+}
@@ -2,38 +2,40 @@ package gcis
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"sync"
 )
 
 type BusinessService service
 
 type BusinessBasicInformationInput struct {
 	PresidentNo string
-	Agency      string
+	// Agency is the code of register agency, e.g. 376610000A, as in Agency of the output
+	Agency string
 }
 
 type BusinessBasicInformationOutput struct {
-	PresidentNo                  string `json:"President_No"`
-	BusinessName                 string `json:"Business_Name"`
-	BusinessCurrentStatus        string `json:"Business_Current_Status"`
-	BusinessCurrentStatusDesc    string `json:"Business_Current_Status_Desc"`
-	BusinessRegisterFunds        int64  `json:"Business_Register_Funds"`
-	ResponsibleName              string `json:"responsible_name"`
-	BusinessOrganizationType     string `json:"Business_Organization_Type"`
-	BusinessOrganizationTypeDesc string `json:"Business_Organization_Type_Desc"`
-	Agency                       string `json:"Agency"`
-	AgencyDesc                   string `json:"Agency_Desc"`
-	BusinessAddress              string `json:"Business_Address"`
-	BusinessSetupApproveDate     string `json:"Business_Setup_Approve_Date"`
-	BusinessLastChangeDate       string `json:"Business_Last_Change_Date"`
+	PresidentNo                  string `json:"President_No" xml:"President_No"`
+	BusinessName                 string `json:"Business_Name" xml:"Business_Name"`
+	BusinessCurrentStatus        string `json:"Business_Current_Status" xml:"Business_Current_Status"`
+	BusinessCurrentStatusDesc    string `json:"Business_Current_Status_Desc" xml:"Business_Current_Status_Desc"`
+	BusinessRegisterFunds        int64  `json:"Business_Register_Funds" xml:"Business_Register_Funds"`
+	ResponsibleName              string `json:"responsible_name" xml:"responsible_name"`
+	BusinessOrganizationType     string `json:"Business_Organization_Type" xml:"Business_Organization_Type"`
+	BusinessOrganizationTypeDesc string `json:"Business_Organization_Type_Desc" xml:"Business_Organization_Type_Desc"`
+	Agency                       string `json:"Agency" xml:"Agency"`
+	AgencyDesc                   string `json:"Agency_Desc" xml:"Agency_Desc"`
+	BusinessAddress              string `json:"Business_Address" xml:"Business_Address"`
+	BusinessSetupApproveDate     string `json:"Business_Setup_Approve_Date" xml:"Business_Setup_Approve_Date"`
+	BusinessLastChangeDate       string `json:"Business_Last_Change_Date" xml:"Business_Last_Change_Date"`
 }
 
 // GetBasicInformation fetches the basic information of company by president no and register agency.
 func (s *BusinessService) GetBasicInformation(ctx context.Context, input *BusinessBasicInformationInput) (*BusinessBasicInformationOutput, *Response, error) {
-	u := fmt.Sprintf("od/data/api/7E6AFA72-AD6A-46D3-8681-ED77951D912D?$format=json&$filter=President_No eq %s and Agency eq %s", input.PresidentNo, input.Agency)
-	outputs := make([]BusinessBasicInformationOutput, 1)
+	q := &Query{Filter: And(Eq("President_No", input.PresidentNo), Eq("Agency", input.Agency))}
+	outputs := []BusinessBasicInformationOutput{}
 
-	resp, err := s.client.get(ctx, u, &outputs)
+	resp, err := s.client.Query(ctx, "7E6AFA72-AD6A-46D3-8681-ED77951D912D", q, &outputs)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -44,22 +46,22 @@ func (s *BusinessService) GetBasicInformation(ctx context.Context, input *Busine
 }
 
 type BusinessBasicInformationAndBusinessOutput struct {
-	PresidentNo               string        `json:"President_No"`
-	BusinessName              string        `json:"Business_Name"`
-	BusinessCurrentStatus     string        `json:"Business_Current_Status"`
-	BusinessCurrentStatusDesc string        `json:"Business_Current_Status_Desc"`
-	Agency                    string        `json:"Agency"`
-	AgencyDesc                string        `json:"Agency_Desc"`
-	BusinessSetupApproveDate  string        `json:"Business_Setup_Approve_Date"`
-	BusinessItemOld           []CmpBusiness `json:"Business_Item_Old"`
+	PresidentNo               string        `json:"President_No" xml:"President_No"`
+	BusinessName              string        `json:"Business_Name" xml:"Business_Name"`
+	BusinessCurrentStatus     string        `json:"Business_Current_Status" xml:"Business_Current_Status"`
+	BusinessCurrentStatusDesc string        `json:"Business_Current_Status_Desc" xml:"Business_Current_Status_Desc"`
+	Agency                    string        `json:"Agency" xml:"Agency"`
+	AgencyDesc                string        `json:"Agency_Desc" xml:"Agency_Desc"`
+	BusinessSetupApproveDate  string        `json:"Business_Setup_Approve_Date" xml:"Business_Setup_Approve_Date"`
+	BusinessItemOld           []CmpBusiness `json:"Business_Item_Old" xml:"Business_Item_Old"`
 }
 
 // GetBasicInformationAndBusiness fetches the basic information and business of company by president no and register agency.
 func (s *BusinessService) GetBasicInformationAndBusiness(ctx context.Context, input *BusinessBasicInformationInput) (*BusinessBasicInformationAndBusinessOutput, *Response, error) {
-	u := fmt.Sprintf("od/data/api/F570BC9A-DA4C-4813-8087-FB9CE95F9D38?$format=json&$filter=President_No eq %s and Agency eq %s", input.PresidentNo, input.Agency)
-	outputs := make([]BusinessBasicInformationAndBusinessOutput, 1)
+	q := &Query{Filter: And(Eq("President_No", input.PresidentNo), Eq("Agency", input.Agency))}
+	outputs := []BusinessBasicInformationAndBusinessOutput{}
 
-	resp, err := s.client.get(ctx, u, &outputs)
+	resp, err := s.client.Query(ctx, "F570BC9A-DA4C-4813-8087-FB9CE95F9D38", q, &outputs)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -68,3 +70,156 @@ func (s *BusinessService) GetBasicInformationAndBusiness(ctx context.Context, in
 	}
 	return &outputs[0], resp, nil
 }
+
+type BusinessByKeywordInput struct {
+	BusinessName string
+	// Optional filters, left out of the query when empty.
+	BusinessCurrentStatus string
+	Agency                string
+
+	SearchOptions
+}
+
+// SearchByKeyword searches the basic information of businesses by keyword.
+func (s *BusinessService) SearchByKeyword(ctx context.Context, input *BusinessByKeywordInput) ([]BusinessBasicInformationOutput, *Response, error) {
+	opts, err := input.SearchOptions.withDefaults()
+	if err != nil {
+		return nil, nil, err
+	}
+	filter := Like("Business_Name", input.BusinessName)
+	if input.BusinessCurrentStatus != "" {
+		filter = And(filter, Eq("Business_Current_Status", input.BusinessCurrentStatus))
+	}
+	if input.Agency != "" {
+		filter = And(filter, Eq("Agency", input.Agency))
+	}
+	q := &Query{
+		Filter: filter,
+		Skip:   opts.Skip,
+		Top:    opts.Top,
+	}
+	outputs := []BusinessBasicInformationOutput{}
+
+	resp, err := s.client.Query(ctx, "426D5542-5F05-43EB-83F9-F1300F14E1F1", q, &outputs)
+	if err != nil {
+		return nil, resp, err
+	}
+	return outputs, resp, nil
+}
+
+type BusinessByPresidentNoInput struct {
+	PresidentNo string
+	// Agencies are the register agencies to look up. GCIS publishes no list of the agencies, so they are required.
+	Agencies []string
+	// Workers is the number of requests sent in parallel, 1 by default.
+	Workers int
+}
+
+type BusinessByAgencyOutput struct {
+	Agency string
+	// Business is nil if the business is not registered by the agency.
+	Business *BusinessBasicInformationOutput
+	Response *Response
+}
+
+// SearchByPresidentNo searches the basic information of businesses by president no across register agencies.
+// The business dataset is only queried by president no together with agency, so it sends a GetBasicInformation
+// request for every agency, with the number of workers in parallel and subject to WithRateLimit. It returns an
+// output for every agency queried, in the order of agencies, and stops at the first error.
+// A business registered by an agency missing from input.Agencies is not found.
+func (s *BusinessService) SearchByPresidentNo(ctx context.Context, input *BusinessByPresidentNoInput) ([]BusinessByAgencyOutput, error) {
+	if len(input.Agencies) == 0 {
+		return nil, errors.New("search by president no requires Agencies")
+	}
+	workers := input.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		err     error
+		outputs = make([]BusinessByAgencyOutput, len(input.Agencies))
+		indexes = make(chan int)
+	)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				agency := input.Agencies[i]
+				output, resp, e := s.GetBasicInformation(ctx, &BusinessBasicInformationInput{
+					PresidentNo: input.PresidentNo,
+					Agency:      agency,
+				})
+				if e != nil {
+					mu.Lock()
+					if err == nil {
+						err = e
+						cancel()
+					}
+					mu.Unlock()
+					continue
+				}
+				outputs[i] = BusinessByAgencyOutput{Agency: agency, Business: output, Response: resp}
+			}
+		}()
+	}
+	for i := range input.Agencies {
+		select {
+		case indexes <- i:
+			continue
+		case <-ctx.Done():
+		}
+		break
+	}
+	close(indexes)
+	wg.Wait()
+
+	if err != nil {
+		return nil, err
+	}
+	if e := ctx.Err(); e != nil {
+		return nil, e
+	}
+	return outputs, nil
+}
+
+type BusinessByAddressInput struct {
+	BusinessAddress string
+	// Optional filters, left out of the query when empty.
+	BusinessCurrentStatus string
+	Agency                string
+
+	SearchOptions
+}
+
+// SearchByAddress searches the basic information of businesses by registered address.
+func (s *BusinessService) SearchByAddress(ctx context.Context, input *BusinessByAddressInput) ([]BusinessBasicInformationOutput, *Response, error) {
+	opts, err := input.SearchOptions.withDefaults()
+	if err != nil {
+		return nil, nil, err
+	}
+	filter := Like("Business_Address", input.BusinessAddress)
+	if input.BusinessCurrentStatus != "" {
+		filter = And(filter, Eq("Business_Current_Status", input.BusinessCurrentStatus))
+	}
+	if input.Agency != "" {
+		filter = And(filter, Eq("Agency", input.Agency))
+	}
+	q := &Query{
+		Filter: filter,
+		Skip:   opts.Skip,
+		Top:    opts.Top,
+	}
+	outputs := []BusinessBasicInformationOutput{}
+
+	resp, err := s.client.Query(ctx, "426D5542-5F05-43EB-83F9-F1300F14E1F1", q, &outputs)
+	if err != nil {
+		return nil, resp, err
+	}
+	return outputs, resp, nil
+}
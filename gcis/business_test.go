@@ -2,7 +2,10 @@ package gcis
 
 import (
 	"context"
+	"net/http"
 	"reflect"
+	"strings"
+	"sync"
 	"testing"
 )
 
@@ -147,3 +150,157 @@ func TestBusinessService_GetBasicInformationAndBusiness_notFound(t *testing.T) {
 		t.Errorf("Bussiness.GetBasicInformationAndBusiness = %+v, want nil", got)
 	}
 }
+
+func TestBusinessService_SearchByKeyword(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/od/data/api/426D5542-5F05-43EB-83F9-F1300F14E1F1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, "$filter", "Business_Name like '鼎勝' and Business_Current_Status eq '01' and Agency eq '376610000A'")
+		testQuery(t, r, "$skip", "0")
+		testQuery(t, r, "$top", "50")
+
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		w.Write(businessBasicInformationJSON)
+	})
+
+	got, _, err := client.Bussiness.SearchByKeyword(context.Background(),
+		&BusinessByKeywordInput{
+			BusinessName:          "鼎勝",
+			BusinessCurrentStatus: "01",
+			Agency:                "376610000A",
+		})
+	if err != nil {
+		t.Errorf("Bussiness.SearchByKeyword returned error: %v", err)
+	}
+	if want := []BusinessBasicInformationOutput{*businessBasicInformation}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Bussiness.SearchByKeyword = %+v, want %+v", got, want)
+	}
+}
+
+func TestBusinessService_SearchByKeyword_withoutFilters(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/od/data/api/426D5542-5F05-43EB-83F9-F1300F14E1F1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, "$filter", "Business_Name like '鼎勝'")
+
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	})
+
+	got, _, err := client.Bussiness.SearchByKeyword(context.Background(), &BusinessByKeywordInput{BusinessName: "鼎勝"})
+	if err != nil {
+		t.Errorf("Bussiness.SearchByKeyword returned error: %v", err)
+	}
+	if want := []BusinessBasicInformationOutput{}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Bussiness.SearchByKeyword = %+v, want %+v", got, want)
+	}
+}
+
+func TestBusinessService_SearchByPresidentNo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	var agencies []string
+	mux.HandleFunc("/od/data/api/7E6AFA72-AD6A-46D3-8681-ED77951D912D", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		filter := r.URL.Query().Get("$filter")
+		if !strings.HasPrefix(filter, "President_No eq '26459190' and Agency eq ") {
+			t.Errorf("Request query $filter: %v, want filter on President_No and Agency", filter)
+		}
+		mu.Lock()
+		agencies = append(agencies, filter[strings.LastIndex(filter, " ")+1:])
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		if strings.HasSuffix(filter, "'376610000A'") {
+			w.Write(businessBasicInformationJSON)
+		}
+	})
+
+	got, err := client.Bussiness.SearchByPresidentNo(context.Background(),
+		&BusinessByPresidentNoInput{
+			PresidentNo: "26459190",
+			Agencies:    []string{"379100000G", "376610000A", "382000000A"},
+			Workers:     2,
+		})
+	if err != nil {
+		t.Errorf("Bussiness.SearchByPresidentNo returned error: %v", err)
+	}
+	var agenciesGot []string
+	var businesses []*BusinessBasicInformationOutput
+	for _, output := range got {
+		agenciesGot = append(agenciesGot, output.Agency)
+		businesses = append(businesses, output.Business)
+		if output.Response == nil {
+			t.Errorf("Bussiness.SearchByPresidentNo Response of %v is nil", output.Agency)
+		}
+	}
+	if want := []string{"379100000G", "376610000A", "382000000A"}; !reflect.DeepEqual(agenciesGot, want) {
+		t.Errorf("Bussiness.SearchByPresidentNo agencies = %v, want %v", agenciesGot, want)
+	}
+	if want := []*BusinessBasicInformationOutput{nil, businessBasicInformation, nil}; !reflect.DeepEqual(businesses, want) {
+		t.Errorf("Bussiness.SearchByPresidentNo businesses = %+v, want %+v", businesses, want)
+	}
+	if got, want := len(agencies), 3; got != want {
+		t.Errorf("Bussiness.SearchByPresidentNo sent %v requests, want %v", got, want)
+	}
+}
+
+func TestBusinessService_SearchByPresidentNo_error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/od/data/api/7E6AFA72-AD6A-46D3-8681-ED77951D912D", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	got, err := client.Bussiness.SearchByPresidentNo(context.Background(),
+		&BusinessByPresidentNoInput{
+			PresidentNo: "26459190",
+			Agencies:    []string{"379100000G", "376610000A"},
+		})
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("Bussiness.SearchByPresidentNo returned error: %v, want *ErrorResponse", err)
+	}
+	if got != nil {
+		t.Errorf("Bussiness.SearchByPresidentNo = %+v, want nil", got)
+	}
+}
+
+func TestBusinessService_SearchByPresidentNo_noAgencies(t *testing.T) {
+	_, err := NewClient().Bussiness.SearchByPresidentNo(context.Background(), &BusinessByPresidentNoInput{PresidentNo: "26459190"})
+	if err == nil {
+		t.Errorf("Bussiness.SearchByPresidentNo expected error")
+	}
+}
+
+func TestBusinessService_SearchByAddress(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/od/data/api/426D5542-5F05-43EB-83F9-F1300F14E1F1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, "$filter", "Business_Address like '臺南市安平區華平里怡平路485號'")
+		testQuery(t, r, "$skip", "50")
+		testQuery(t, r, "$top", "50")
+
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		w.Write(businessBasicInformationJSON)
+	})
+
+	got, _, err := client.Bussiness.SearchByAddress(context.Background(),
+		&BusinessByAddressInput{
+			BusinessAddress: "臺南市安平區華平里怡平路485號",
+			SearchOptions:   SearchOptions{Skip: 50},
+		})
+	if err != nil {
+		t.Errorf("Bussiness.SearchByAddress returned error: %v", err)
+	}
+	if want := []BusinessBasicInformationOutput{*businessBasicInformation}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Bussiness.SearchByAddress = %+v, want %+v", got, want)
+	}
+}
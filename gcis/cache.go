@@ -0,0 +1,35 @@
+package gcis
+
+import "sync"
+
+// Cache stores the raw API responses by request URL.
+type Cache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, data []byte)
+}
+
+// MemoryCache is an in-memory Cache safe for concurrent use. It never evicts entries.
+type MemoryCache struct {
+	mu    sync.RWMutex
+	items map[string][]byte
+}
+
+// NewMemoryCache returns an empty MemoryCache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{items: make(map[string][]byte)}
+}
+
+// Get returns the cached data of key.
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	data, ok := m.items[key]
+	return data, ok
+}
+
+// Set caches the data of key.
+func (m *MemoryCache) Set(key string, data []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.items[key] = data
+}
@@ -0,0 +1,24 @@
+package gcis
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMemoryCache(t *testing.T) {
+	c := NewMemoryCache()
+
+	if _, ok := c.Get("foo"); ok {
+		t.Errorf("MemoryCache.Get found foo in empty cache")
+	}
+
+	c.Set("foo", []byte("bar"))
+
+	got, ok := c.Get("foo")
+	if !ok {
+		t.Errorf("MemoryCache.Get did not find foo")
+	}
+	if want := []byte("bar"); !reflect.DeepEqual(got, want) {
+		t.Errorf("MemoryCache.Get = %q, want %q", got, want)
+	}
+}
@@ -1,14 +1,19 @@
 package gcis
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/http/httputil"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 )
 
 const (
@@ -26,17 +31,27 @@ type Client struct {
 
 	BaseURL   *url.URL
 	UserAgent string
+	// Format is the response format requested when Query.Format is empty, FormatJSON by default, see WithFormat.
+	// FormatCSV can only be streamed into io.Writer, other methods fail with it.
+	Format string
+
+	timeout time.Duration
+	limiter *rateLimiter
+	cache   Cache
+	logger  Logger
 
 	// Reuse a single struct instead of allocating one for each service on the heap.
 	common service
 
 	// Services used for talking to different parts of the GCIS API.
+	Branch    *BranchService
 	Bussiness *BusinessService
 	Company   *CompanyService
+	Director  *DirectorService
 }
 
-// NewClient returns a new GCIS API client.
-func NewClient() *Client {
+// NewClient returns a new GCIS API client configured by opts.
+func NewClient(opts ...Option) *Client {
 	baseURL, _ := url.Parse(defaultBaseURL)
 
 	c := &Client{
@@ -44,10 +59,15 @@ func NewClient() *Client {
 		BaseURL:    baseURL,
 		UserAgent:  defaultUserAgent,
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
 
 	c.common.client = c
+	c.Branch = (*BranchService)(&c.common)
 	c.Bussiness = (*BusinessService)(&c.common)
 	c.Company = (*CompanyService)(&c.common)
+	c.Director = (*DirectorService)(&c.common)
 
 	return c
 }
@@ -84,9 +104,21 @@ func newResponse(r *http.Response) *Response {
 }
 
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	return c.do(ctx, req, CheckResponse, func(resp *http.Response) error {
+		return decodeBody(resp, v)
+	})
+}
+
+// do sends the request, checks the response with check and then reads its body with decode.
+func (c *Client) do(ctx context.Context, req *http.Request, check, decode func(*http.Response) error) (*Response, error) {
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
 	req = req.WithContext(ctx)
 
-	resp, err := c.HTTPClient.Do(req)
+	resp, err := c.send(req)
 	if err != nil {
 		select {
 		case <-ctx.Done():
@@ -100,31 +132,86 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 
 	response := newResponse(resp)
 
-	err = CheckResponse(resp)
+	err = check(resp)
 	if err != nil {
 		return response, err
 	}
 
-	if v != nil {
-		if w, ok := v.(io.Writer); ok {
-			io.Copy(w, resp.Body)
-		} else {
-			var body io.Reader
-			if resp.ContentLength == 0 {
-				// Workaround for empty body
-				body = strings.NewReader("[]")
-			} else {
-				body = resp.Body
-			}
+	return response, decode(resp)
+}
+
+// decodeBody decodes the response body into v.
+func decodeBody(resp *http.Response, v interface{}) error {
+	if v == nil {
+		return nil
+	}
+	if rs, ok := v.(*recordStream); ok {
+		return rs.decode(resp.Body, isXML(resp.Header.Get("Content-Type")))
+	}
+	if w, ok := v.(io.Writer); ok {
+		_, err := io.Copy(w, resp.Body)
+		return err
+	}
+	if isXML(resp.Header.Get("Content-Type")) {
+		return decodeXML(resp.Body, v)
+	}
+
+	var body io.Reader
+	if resp.ContentLength == 0 {
+		// Workaround for empty body
+		body = strings.NewReader("[]")
+	} else {
+		body = resp.Body
+	}
 
-			err = json.NewDecoder(body).Decode(v)
-			if err == io.EOF {
-				err = nil // ignore EOF errors caused by empty response body
+	err := json.NewDecoder(body).Decode(v)
+	if err == io.EOF {
+		err = nil // ignore EOF errors caused by empty response body
+	}
+	return err
+}
+
+// send sends the request, serving it from the cache when possible.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	cacheable := c.cache != nil && req.Method == "GET"
+	key := req.URL.String()
+	if cacheable {
+		if data, ok := c.cache.Get(key); ok {
+			resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(data)), req)
+			if err == nil {
+				c.logf("%s %s: cached", req.Method, key)
+				return resp, nil
 			}
 		}
 	}
 
-	return response, err
+	if c.limiter != nil {
+		if err := c.limiter.wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		c.logf("%s %s: %v", req.Method, key, err)
+		return nil, err
+	}
+	c.logf("%s %s: %s in %v", req.Method, key, resp.Status, time.Since(start))
+
+	if cacheable && resp.StatusCode == http.StatusOK && hasContentType(resp.Header.Get("Content-Type"), contentTypes) {
+		// DumpResponse replaces the body, so that it can still be read
+		if data, err := httputil.DumpResponse(resp, true); err == nil {
+			c.cache.Set(key, data)
+		}
+	}
+	return resp, nil
+}
+
+func (c *Client) logf(format string, v ...interface{}) {
+	if c.logger != nil {
+		c.logger.Printf(format, v...)
+	}
 }
 
 func (c *Client) get(ctx context.Context, urlStr string, v interface{}) (*Response, error) {
@@ -140,6 +227,68 @@ func (c *Client) get(ctx context.Context, urlStr string, v interface{}) (*Respon
 	return resp, nil
 }
 
+// Query queries the dataset of GCIS API by id, e.g. 5F64D864-61CB-4D0D-8AD9-492047CC1EA6.
+// The CSV format can only be queried into io.Writer.
+func (c *Client) Query(ctx context.Context, datasetID string, q *Query, v interface{}) (*Response, error) {
+	if format := q.Format; format == FormatCSV || (format == "" && c.Format == FormatCSV) {
+		if _, ok := v.(io.Writer); !ok {
+			return nil, fmt.Errorf("format %s can only be queried into io.Writer", FormatCSV)
+		}
+	}
+	return c.get(ctx, c.queryURL(datasetID, q), v)
+}
+
+// Count counts the records of the dataset matching the filter of query. GCIS API serves the datasets with OData,
+// the count is addressed by the $count segment as in OData Version 4.0 Part 2: URL Conventions, 4.8 Addressing
+// the Count of a Collection, and returned as plain text. Skip and Top of the query are ignored.
+func (c *Client) Count(ctx context.Context, datasetID string, q *Query) (int, *Response, error) {
+	req, err := c.NewRequest("GET", c.encodeURL("od/data/api/"+datasetID+"/$count", &Query{Filter: q.Filter, Format: q.Format}), nil)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var n int
+	resp, err := c.do(ctx, req, func(resp *http.Response) error {
+		return checkResponse(resp, countContentTypes)
+	}, func(resp *http.Response) error {
+		data, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		n, err = strconv.Atoi(strings.TrimSpace(string(data)))
+		if err != nil {
+			return &ErrorResponse{Message: fmt.Sprintf("unexpected count: %q", data)}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, resp, err
+	}
+	return n, resp, nil
+}
+
+// countContentTypes are the content types of count responses accepted by Client.Count.
+var countContentTypes = []string{"text/plain"}
+
+func (c *Client) queryURL(datasetID string, q *Query) string {
+	return c.encodeURL("od/data/api/"+datasetID, q)
+}
+
+// encodeURL returns the URL of path with the query, requesting Client.Format when Query.Format is empty.
+func (c *Client) encodeURL(path string, q *Query) string {
+	if q.Format == "" && c.Format != "" {
+		query := *q
+		query.Format = c.Format
+		q = &query
+	}
+	return path + "?" + q.Encode()
+}
+
+// queryURLLength returns the length of request URL of the query.
+func (c *Client) queryURLLength(datasetID string, q *Query) int {
+	return len(c.BaseURL.String()) + len(c.queryURL(datasetID, q))
+}
+
 // ErrorResponse reports error caused by an API request.
 type ErrorResponse struct {
 	Message string
@@ -151,13 +300,17 @@ func (e *ErrorResponse) Error() string {
 
 // CheckResponse checks the API response for errors.
 func CheckResponse(r *http.Response) error {
+	return checkResponse(r, contentTypes)
+}
+
+func checkResponse(r *http.Response, contentTypes []string) error {
 	// GCIS API always return status code 200
 	if code := r.StatusCode; code != 200 {
 		return &ErrorResponse{
 			Message: fmt.Sprintf("unexpected status code: %d", code),
 		}
 	}
-	if ct := r.Header.Get("Content-type"); !strings.HasPrefix(ct, "application/json") {
+	if ct := r.Header.Get("Content-type"); !hasContentType(ct, contentTypes) {
 		err := &ErrorResponse{
 			Message: "unexpected body",
 		}
@@ -172,7 +325,63 @@ func CheckResponse(r *http.Response) error {
 	return nil
 }
 
+func hasContentType(ct string, contentTypes []string) bool {
+	for _, t := range contentTypes {
+		if strings.HasPrefix(ct, t) {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	// DefaultTop is the page size used when SearchOptions.Top is zero.
+	DefaultTop = 50
+	// MaxTop is the maximum page size of GCIS API, whose dataset API descriptions on https://data.gcis.nat.gov.tw/
+	// limit $top to 1000 records. It applies to every method taking SearchOptions, i.e. the SearchBy methods of
+	// CompanyService and BusinessService, and BranchService.ListByCompany.
+	MaxTop = 1000
+)
+
+// SearchOptions specifies the paging of search results. Top defaults to DefaultTop and is limited to MaxTop.
 type SearchOptions struct {
 	Skip int
 	Top  int
 }
+
+// SearchOptionsError reports an invalid field of SearchOptions.
+type SearchOptionsError struct {
+	Field string
+	Value int
+}
+
+func (e *SearchOptionsError) Error() string {
+	switch e.Field {
+	case "Skip":
+		return fmt.Sprintf("invalid search options: Skip %d is negative", e.Value)
+	default:
+		return fmt.Sprintf("invalid search options: Top %d is out of range [0, %d]", e.Value, MaxTop)
+	}
+}
+
+// Validate checks that Skip is not negative and Top is between 0 and MaxTop.
+func (o SearchOptions) Validate() error {
+	if o.Skip < 0 {
+		return &SearchOptionsError{Field: "Skip", Value: o.Skip}
+	}
+	if o.Top < 0 || o.Top > MaxTop {
+		return &SearchOptionsError{Field: "Top", Value: o.Top}
+	}
+	return nil
+}
+
+// withDefaults returns a validated copy of the options with Top defaulted to DefaultTop.
+func (o SearchOptions) withDefaults() (SearchOptions, error) {
+	if err := o.Validate(); err != nil {
+		return o, err
+	}
+	if o.Top == 0 {
+		o.Top = DefaultTop
+	}
+	return o, nil
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -29,9 +30,8 @@ func setup() {
 	server = httptest.NewServer(mux)
 
 	// GCIS client configured to use test server
-	client = NewClient()
 	u, _ := url.Parse(server.URL)
-	client.BaseURL = u
+	client = NewClient(WithBaseURL(u))
 }
 
 // teardown closes the test HTTP server.
@@ -49,12 +49,30 @@ func handle(t *testing.T, pattern string, body []byte) {
 	})
 }
 
+// handleChunked is like handle, but serves body with chunked transfer encoding, so that the response has no length.
+func handleChunked(t *testing.T, pattern string, body []byte) {
+	mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		w.Write(body)
+	})
+}
+
 func testMethod(t *testing.T, r *http.Request, want string) {
 	if got := r.Method; got != want {
 		t.Errorf("Request method: %v, want %v", got, want)
 	}
 }
 
+func testQuery(t *testing.T, r *http.Request, key, want string) {
+	if got := r.URL.Query().Get(key); got != want {
+		t.Errorf("Request query %v: %v, want %v", key, got, want)
+	}
+}
+
 func TestNewClient(t *testing.T) {
 	c := NewClient()
 
@@ -98,6 +116,105 @@ func TestDo(t *testing.T) {
 	client.Do(context.Background(), req, nil)
 }
 
+func TestQuery(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/od/data/api/5F64D864-61CB-4D0D-8AD9-492047CC1EA6", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, "$format", "json")
+		testQuery(t, r, "$filter", "Company_Name like '宏碁' and Company_Status eq '01'")
+		testQuery(t, r, "$skip", "0")
+		testQuery(t, r, "$top", "10")
+
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		w.Write(companyBasicInformationJSON)
+	})
+
+	var got []CompanyBasicInformationOutput
+	_, err := client.Query(context.Background(), "5F64D864-61CB-4D0D-8AD9-492047CC1EA6",
+		&Query{
+			Filter: And(Like("Company_Name", "宏碁"), Eq("Company_Status", "01")),
+			Top:    10,
+		}, &got)
+	if err != nil {
+		t.Errorf("Query returned error: %v", err)
+	}
+	if want := []CompanyBasicInformationOutput{*companyBasicInformation}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Query = %+v, want %+v", got, want)
+	}
+}
+
+func TestCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/od/data/api/5F64D864-61CB-4D0D-8AD9-492047CC1EA6/$count", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, "$format", "json")
+		testQuery(t, r, "$filter", "Company_Name like '宏碁'")
+		testQuery(t, r, "$top", "")
+
+		w.Header().Set("Content-Type", "text/plain;charset=UTF-8")
+		fmt.Fprint(w, "1234")
+	})
+
+	got, _, err := client.Count(context.Background(), "5F64D864-61CB-4D0D-8AD9-492047CC1EA6",
+		&Query{Filter: Like("Company_Name", "宏碁"), Top: 10})
+	if err != nil {
+		t.Errorf("Count returned error: %v", err)
+	}
+	if want := 1234; got != want {
+		t.Errorf("Count = %v, want %v", got, want)
+	}
+}
+
+func TestQuery_textError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/od/data/api/5F64D864-61CB-4D0D-8AD9-492047CC1EA6", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain;charset=UTF-8")
+		fmt.Fprint(w, "$format參數有誤，請查明後繼續。")
+	})
+
+	var got []CompanyBasicInformationOutput
+	_, err := client.Query(context.Background(), "5F64D864-61CB-4D0D-8AD9-492047CC1EA6", &Query{}, &got)
+	if e, ok := err.(*ErrorResponse); !ok || e.Message != "$format參數有誤，請查明後繼續。" {
+		t.Errorf("Query returned error: %#v, want *ErrorResponse with server message", err)
+	}
+}
+
+func TestCount_contentType(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/od/data/api/5F64D864-61CB-4D0D-8AD9-492047CC1EA6/$count", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		fmt.Fprint(w, "1234")
+	})
+
+	_, _, err := client.Count(context.Background(), "5F64D864-61CB-4D0D-8AD9-492047CC1EA6", &Query{})
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("Count returned error: %v, want *ErrorResponse", err)
+	}
+}
+
+func TestCount_invalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/od/data/api/5F64D864-61CB-4D0D-8AD9-492047CC1EA6/$count", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain;charset=UTF-8")
+		fmt.Fprint(w, "unknown")
+	})
+
+	_, _, err := client.Count(context.Background(), "5F64D864-61CB-4D0D-8AD9-492047CC1EA6", &Query{})
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("Count returned error: %v, want *ErrorResponse", err)
+	}
+}
+
 func TestCheckResponse(t *testing.T) {
 	tests := []struct {
 		statusCode int
@@ -142,3 +259,36 @@ func TestCheckResponse(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckResponse_contentTypes(t *testing.T) {
+	for _, ct := range []string{"application/json;charset=UTF-8", "application/xml", "text/xml;charset=UTF-8", "text/csv"} {
+		res := &http.Response{
+			Request:    &http.Request{},
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {ct}},
+			Body:       ioutil.NopCloser(strings.NewReader("")),
+		}
+		if err := CheckResponse(res); err != nil {
+			t.Errorf("CheckResponse(%v) returned error: %v", ct, err)
+		}
+	}
+}
+
+func TestSearchOptions_Validate(t *testing.T) {
+	tests := []struct {
+		opts SearchOptions
+		want error
+	}{
+		{SearchOptions{}, nil},
+		{SearchOptions{Skip: 100, Top: MaxTop}, nil},
+		{SearchOptions{Skip: -1}, &SearchOptionsError{Field: "Skip", Value: -1}},
+		{SearchOptions{Top: -1}, &SearchOptionsError{Field: "Top", Value: -1}},
+		{SearchOptions{Top: MaxTop + 1}, &SearchOptionsError{Field: "Top", Value: MaxTop + 1}},
+	}
+
+	for i, test := range tests {
+		if got := test.opts.Validate(); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("(%v) SearchOptions.Validate = %v, want %v", i, got, test.want)
+		}
+	}
+}
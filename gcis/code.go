@@ -0,0 +1,37 @@
+package gcis
+
+// Only the company status code table has a published source, the spreadsheet cited by CompanyStatusCodes, which is
+// copied offline as the library does not decode spreadsheets. No source was found for the tables of business
+// organization types and register agencies, so there are none; their descriptions come with each record in
+// Business_Organization_Type_Desc and Agency_Desc instead.
+
+// Code is an entry of code table.
+type Code struct {
+	Code string
+	Desc string
+}
+
+// CompanyStatusCodes is an offline copy of the company status code table,
+// see https://data.gcis.nat.gov.tw/od/cmpStatusCodeData?type=xls
+var CompanyStatusCodes = []Code{
+	{"01", "核准設立"},
+	{"02", "停業"},
+	{"03", "解散"},
+	{"04", "撤銷"},
+	{"05", "破產"},
+	{"06", "合併解散"},
+	{"07", "申覆(辯)期"},
+	{"08", "廢止"},
+	{"09", "撤回認許"},
+	{"10", "清理完結"},
+}
+
+// LookupCode returns the description of code in the code table.
+func LookupCode(codes []Code, code string) (string, bool) {
+	for _, c := range codes {
+		if c.Code == code {
+			return c.Desc, true
+		}
+	}
+	return "", false
+}
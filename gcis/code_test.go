@@ -0,0 +1,12 @@
+package gcis
+
+import "testing"
+
+func TestLookupCode(t *testing.T) {
+	if got, ok := LookupCode(CompanyStatusCodes, "01"); !ok || got != "核准設立" {
+		t.Errorf("LookupCode = %v, %v, want 核准設立, true", got, ok)
+	}
+	if got, ok := LookupCode(CompanyStatusCodes, "99"); ok {
+		t.Errorf("LookupCode = %v, %v, want false", got, ok)
+	}
+}
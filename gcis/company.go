@@ -2,7 +2,9 @@ package gcis
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 )
 
 type CompanyService service
@@ -12,30 +14,32 @@ type CompanyBasicInformationInput struct {
 }
 
 type CompanyBasicInformationOutput struct {
-	BusinessAccountingNO     string `json:"Business_Accounting_NO"`
-	CompanyStatusDesc        string `json:"Company_Status_Desc"`
-	CompanyName              string `json:"Company_Name"`
-	CapitalStockAmount       int64  `json:"Capital_Stock_Amount"`
-	PaidInCapitalAmount      int64  `json:"Paid_In_Capital_Amount"`
-	ResponsibleName          string `json:"Responsible_Name"`
-	CompanyLocation          string `json:"Company_Location"`
-	RegisterOrganizationDesc string `json:"Register_Organization_Desc"`
-	CompanySetupDate         string `json:"Company_Setup_Date"`
-	ChangeOfApprovalData     string `json:"Change_Of_Approval_Data"`
-	RevokeAppDate            string `json:"Revoke_App_Date"`
-	CaseStatus               string `json:"Case_Status"`
-	CaseStatusDesc           string `json:"Case_Status_Desc"`
-	SusAppDate               string `json:"Sus_App_Date"`
-	SusBegDate               string `json:"Sus_Beg_Date"`
-	SusEndDate               string `json:"Sus_End_Date"`
+	BusinessAccountingNO     string `json:"Business_Accounting_NO" xml:"Business_Accounting_NO"`
+	CompanyStatusDesc        string `json:"Company_Status_Desc" xml:"Company_Status_Desc"`
+	CompanyName              string `json:"Company_Name" xml:"Company_Name"`
+	CapitalStockAmount       int64  `json:"Capital_Stock_Amount" xml:"Capital_Stock_Amount"`
+	PaidInCapitalAmount      int64  `json:"Paid_In_Capital_Amount" xml:"Paid_In_Capital_Amount"`
+	ResponsibleName          string `json:"Responsible_Name" xml:"Responsible_Name"`
+	CompanyLocation          string `json:"Company_Location" xml:"Company_Location"`
+	RegisterOrganizationDesc string `json:"Register_Organization_Desc" xml:"Register_Organization_Desc"`
+	CompanySetupDate         string `json:"Company_Setup_Date" xml:"Company_Setup_Date"`
+	// ChangeOfApprovalData is the date of the latest approved change only, GCIS open data
+	// does not publish the history of change records (capital, name, address, directors).
+	ChangeOfApprovalData string `json:"Change_Of_Approval_Data" xml:"Change_Of_Approval_Data"`
+	RevokeAppDate        string `json:"Revoke_App_Date" xml:"Revoke_App_Date"`
+	CaseStatus           string `json:"Case_Status" xml:"Case_Status"`
+	CaseStatusDesc       string `json:"Case_Status_Desc" xml:"Case_Status_Desc"`
+	SusAppDate           string `json:"Sus_App_Date" xml:"Sus_App_Date"`
+	SusBegDate           string `json:"Sus_Beg_Date" xml:"Sus_Beg_Date"`
+	SusEndDate           string `json:"Sus_End_Date" xml:"Sus_End_Date"`
 }
 
 // GetBasicInformation fetches the basic information of company by accounting no.
 func (s *CompanyService) GetBasicInformation(ctx context.Context, input *CompanyBasicInformationInput) (*CompanyBasicInformationOutput, *Response, error) {
-	u := fmt.Sprintf("od/data/api/5F64D864-61CB-4D0D-8AD9-492047CC1EA6?$format=json&$filter=Business_Accounting_NO eq %s", input.BusinessAccountingNO)
-	outputs := make([]CompanyBasicInformationOutput, 1)
+	q := &Query{Filter: Eq("Business_Accounting_NO", input.BusinessAccountingNO)}
+	outputs := []CompanyBasicInformationOutput{}
 
-	resp, err := s.client.get(ctx, u, &outputs)
+	resp, err := s.client.Query(ctx, "5F64D864-61CB-4D0D-8AD9-492047CC1EA6", q, &outputs)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -45,27 +49,79 @@ func (s *CompanyService) GetBasicInformation(ctx context.Context, input *Company
 	return &outputs[0], resp, nil
 }
 
+// maxURLLength is the maximum length of request URL used when combining filters into a single request.
+const maxURLLength = 2000
+
+type CompanyBasicInformationBatchInput struct {
+	BusinessAccountingNOs []string
+}
+
+// GetBasicInformationBatch fetches the basic information of companies by accounting nos, combining them into as few
+// requests as the URL length allows. The returned map has an entry for every accounting no, nil if not found.
+func (s *CompanyService) GetBasicInformationBatch(ctx context.Context, input *CompanyBasicInformationBatchInput) (map[string]*CompanyBasicInformationOutput, *Response, error) {
+	results := make(map[string]*CompanyBasicInformationOutput, len(input.BusinessAccountingNOs))
+	var nos []string
+	for _, no := range input.BusinessAccountingNOs {
+		if _, ok := results[no]; !ok {
+			results[no] = nil
+			nos = append(nos, no)
+		}
+	}
+
+	var resp *Response
+	for len(nos) > 0 {
+		// Take as many accounting nos as the URL length allows, at least one.
+		n := 1
+		for n < len(nos) && s.client.queryURLLength("5F64D864-61CB-4D0D-8AD9-492047CC1EA6", batchQuery(nos[:n+1])) <= maxURLLength {
+			n++
+		}
+		outputs := []CompanyBasicInformationOutput{}
+
+		var err error
+		resp, err = s.client.Query(ctx, "5F64D864-61CB-4D0D-8AD9-492047CC1EA6", batchQuery(nos[:n]), &outputs)
+		if err != nil {
+			return nil, resp, err
+		}
+		for i := range outputs {
+			if _, ok := results[outputs[i].BusinessAccountingNO]; ok {
+				results[outputs[i].BusinessAccountingNO] = &outputs[i]
+			}
+		}
+		nos = nos[n:]
+	}
+	return results, resp, nil
+}
+
+// batchQuery returns the query matching any of the accounting nos.
+func batchQuery(nos []string) *Query {
+	filters := make([]Filter, len(nos))
+	for i, no := range nos {
+		filters[i] = Eq("Business_Accounting_NO", no)
+	}
+	return &Query{Filter: Or(filters...), Top: len(nos)}
+}
+
 type BasicInformationAndBusinessOutput struct {
-	BusinessAccountingNO string        `json:"Business_Accounting_NO"`
-	CompanyName          string        `json:"Company_Name"`
-	CompanyStatus        string        `json:"Company_Status"`
-	CompanyStatusDesc    string        `json:"Company_Status_Desc"`
-	CompanySetupDate     string        `json:"Company_Setup_Date"`
-	CmpBusiness          []CmpBusiness `json:"Cmp_Business"`
+	BusinessAccountingNO string        `json:"Business_Accounting_NO" xml:"Business_Accounting_NO"`
+	CompanyName          string        `json:"Company_Name" xml:"Company_Name"`
+	CompanyStatus        string        `json:"Company_Status" xml:"Company_Status"`
+	CompanyStatusDesc    string        `json:"Company_Status_Desc" xml:"Company_Status_Desc"`
+	CompanySetupDate     string        `json:"Company_Setup_Date" xml:"Company_Setup_Date"`
+	CmpBusiness          []CmpBusiness `json:"Cmp_Business" xml:"Cmp_Business"`
 }
 
 type CmpBusiness struct {
-	BusinessSeqNO    string `json:"Business_Seq_NO"`
-	BusinessItem     string `json:"Business_Item"`
-	BusinessItemDesc string `json:"business_item_desc"`
+	BusinessSeqNO    string `json:"Business_Seq_NO" xml:"Business_Seq_NO"`
+	BusinessItem     string `json:"Business_Item" xml:"Business_Item"`
+	BusinessItemDesc string `json:"business_item_desc" xml:"business_item_desc"`
 }
 
 // GetBasicInformationAndBusiness fetches the basic information and business of company by accounting no.
 func (s *CompanyService) GetBasicInformationAndBusiness(ctx context.Context, input *CompanyBasicInformationInput) (*BasicInformationAndBusinessOutput, *Response, error) {
-	u := fmt.Sprintf("od/data/api/236EE382-4942-41A9-BD03-CA0709025E7C?$format=json&$filter=Business_Accounting_NO eq %s", input.BusinessAccountingNO)
-	outputs := make([]BasicInformationAndBusinessOutput, 1)
+	q := &Query{Filter: Eq("Business_Accounting_NO", input.BusinessAccountingNO)}
+	outputs := []BasicInformationAndBusinessOutput{}
 
-	resp, err := s.client.get(ctx, u, &outputs)
+	resp, err := s.client.Query(ctx, "236EE382-4942-41A9-BD03-CA0709025E7C", q, &outputs)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -83,40 +139,118 @@ type CompanyByKeywordInput struct {
 }
 
 type CompanyByKeywordOutput struct {
-	BusinessAccountingNO string `json:"Business_Accounting_NO"`
-	CompanyName          string `json:"Company_Name"`
-	// Status see https://data.gcis.nat.gov.tw/od/cmpStatusCodeData?type=xls
-	CompanyStatus            string `json:"Company_Status"`
-	CompanyStatusDesc        string `json:"Company_Status_Desc"`
-	CapitalStockAmount       int64  `json:"Capital_Stock_Amount"`
-	PaidInCapitalAmount      int64  `json:"Paid_In_Capital_Amount"`
-	ResponsibleName          string `json:"Responsible_Name"`
-	RegisterOrganization     string `json:"Register_Organization"`
-	RegisterOrganizationDesc string `json:"Register_Organization_Desc"`
-	CompanyLocation          string `json:"Company_Location"`
-	CompanySetupDate         string `json:"Company_Setup_Date"`
-	ChangeOfApprovalData     string `json:"Change_Of_Approval_Data"`
+	BusinessAccountingNO string `json:"Business_Accounting_NO" xml:"Business_Accounting_NO"`
+	CompanyName          string `json:"Company_Name" xml:"Company_Name"`
+	// Status see CompanyStatusCodes
+	CompanyStatus            string `json:"Company_Status" xml:"Company_Status"`
+	CompanyStatusDesc        string `json:"Company_Status_Desc" xml:"Company_Status_Desc"`
+	CapitalStockAmount       int64  `json:"Capital_Stock_Amount" xml:"Capital_Stock_Amount"`
+	PaidInCapitalAmount      int64  `json:"Paid_In_Capital_Amount" xml:"Paid_In_Capital_Amount"`
+	ResponsibleName          string `json:"Responsible_Name" xml:"Responsible_Name"`
+	RegisterOrganization     string `json:"Register_Organization" xml:"Register_Organization"`
+	RegisterOrganizationDesc string `json:"Register_Organization_Desc" xml:"Register_Organization_Desc"`
+	CompanyLocation          string `json:"Company_Location" xml:"Company_Location"`
+	CompanySetupDate         string `json:"Company_Setup_Date" xml:"Company_Setup_Date"`
+	ChangeOfApprovalData     string `json:"Change_Of_Approval_Data" xml:"Change_Of_Approval_Data"`
 }
 
 // SearchByKeyword searches the information of companies by keyword.
 func (s *CompanyService) SearchByKeyword(ctx context.Context, input *CompanyByKeywordInput) ([]CompanyByKeywordOutput, *Response, error) {
-	if input.Top == 0 {
-		input.Top = 50
+	q, err := keywordQuery(input)
+	if err != nil {
+		return nil, nil, err
 	}
-	u := fmt.Sprintf("od/data/api/6BBA2268-1367-4B42-9CCA-BC17499EBE8C?$format=json&$filter=Company_Name like %s and Company_Status eq %s&$skip=%d&$top=%d",
-		input.CompanyName,
-		input.CompanyStatus,
-		input.Skip,
-		input.Top)
-	outputs := make([]CompanyByKeywordOutput, 1)
+	outputs := []CompanyByKeywordOutput{}
 
-	resp, err := s.client.get(ctx, u, &outputs)
+	resp, err := s.client.Query(ctx, "6BBA2268-1367-4B42-9CCA-BC17499EBE8C", q, &outputs)
 	if err != nil {
 		return nil, resp, err
 	}
 	return outputs, resp, nil
 }
 
+// StreamByKeyword searches the information of companies by keyword like SearchByKeyword, but decodes the companies
+// one at a time from the response and calls fn with each of them. Returning ErrStopStream from fn stops the stream
+// without error.
+func (s *CompanyService) StreamByKeyword(ctx context.Context, input *CompanyByKeywordInput, fn func(CompanyByKeywordOutput) error) (*Response, error) {
+	q, err := keywordQuery(input)
+	if err != nil {
+		return nil, err
+	}
+	output := new(CompanyByKeywordOutput)
+
+	return s.client.Query(ctx, "6BBA2268-1367-4B42-9CCA-BC17499EBE8C", q, newRecordStream(output, func() error {
+		return fn(*output)
+	}))
+}
+
+// CountByKeyword counts the companies matching the keyword, the SearchOptions of input are ignored.
+func (s *CompanyService) CountByKeyword(ctx context.Context, input *CompanyByKeywordInput) (int, *Response, error) {
+	q, err := keywordQuery(input)
+	if err != nil {
+		return 0, nil, err
+	}
+	return s.client.Count(ctx, "6BBA2268-1367-4B42-9CCA-BC17499EBE8C", q)
+}
+
+// CompanyByKeywordPage is a page of the companies searched by keyword, along with the total count of them.
+type CompanyByKeywordPage struct {
+	Companies []CompanyByKeywordOutput
+
+	// Skip and Top are the SearchOptions of the page, with Top defaulted.
+	Skip  int
+	Top   int
+	Total int
+}
+
+// Page returns the page number, starting from 1.
+func (p *CompanyByKeywordPage) Page() int {
+	return p.Skip/p.Top + 1
+}
+
+// Pages returns the total number of pages, at least 1 so that an empty result is "page 1 of 1".
+func (p *CompanyByKeywordPage) Pages() int {
+	if p.Total == 0 {
+		return 1
+	}
+	return (p.Total + p.Top - 1) / p.Top
+}
+
+// SearchByKeywordPage searches the companies by keyword like SearchByKeyword, and counts the total of them with
+// an additional request. The returned Response is the one of the search.
+func (s *CompanyService) SearchByKeywordPage(ctx context.Context, input *CompanyByKeywordInput) (*CompanyByKeywordPage, *Response, error) {
+	q, err := keywordQuery(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	total, resp, err := s.client.Count(ctx, "6BBA2268-1367-4B42-9CCA-BC17499EBE8C", q)
+	if err != nil {
+		return nil, resp, err
+	}
+	outputs, resp, err := s.SearchByKeyword(ctx, input)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &CompanyByKeywordPage{
+		Companies: outputs,
+		Skip:      q.Skip,
+		Top:       q.Top,
+		Total:     total,
+	}, resp, nil
+}
+
+func keywordQuery(input *CompanyByKeywordInput) (*Query, error) {
+	opts, err := input.SearchOptions.withDefaults()
+	if err != nil {
+		return nil, err
+	}
+	return &Query{
+		Filter: And(Like("Company_Name", input.CompanyName), Eq("Company_Status", input.CompanyStatus)),
+		Skip:   opts.Skip,
+		Top:    opts.Top,
+	}, nil
+}
+
 type CompanyByResponsibleNameInput struct {
 	ResponsibleName string
 
@@ -124,22 +258,180 @@ type CompanyByResponsibleNameInput struct {
 }
 
 type CompanyByResponsibleNameOutput struct {
-	BusinessAccountingNO string `json:"Business_Accounting_NO"`
-	CompanyName          string `json:"Company_Name"`
+	BusinessAccountingNO string `json:"Business_Accounting_NO" xml:"Business_Accounting_NO"`
+	CompanyName          string `json:"Company_Name" xml:"Company_Name"`
 }
 
 // SearchByResponsibleName searches the companies by responsible name.
 func (s *CompanyService) SearchByResponsibleName(ctx context.Context, input *CompanyByResponsibleNameInput) ([]CompanyByResponsibleNameOutput, *Response, error) {
-	if input.Top == 0 {
-		input.Top = 50
+	opts, err := input.SearchOptions.withDefaults()
+	if err != nil {
+		return nil, nil, err
+	}
+	q := &Query{
+		Filter: Eq("Responsible_Name", input.ResponsibleName),
+		Skip:   opts.Skip,
+		Top:    opts.Top,
+	}
+	outputs := []CompanyByResponsibleNameOutput{}
+
+	resp, err := s.client.Query(ctx, "4B61A0F1-458C-43F9-93F3-9FD6DA5E1B08", q, &outputs)
+	if err != nil {
+		return nil, resp, err
+	}
+	return outputs, resp, nil
+}
+
+type CompanyManagerOutput struct {
+	SequenceNO  string `json:"Sequence_No" xml:"Sequence_No"`
+	Name        string `json:"Name" xml:"Name"`
+	ArrivalDate string `json:"Arrival_Date" xml:"Arrival_Date"`
+}
+
+// GetManagers fetches the managers of company by accounting no.
+func (s *CompanyService) GetManagers(ctx context.Context, input *CompanyBasicInformationInput) ([]CompanyManagerOutput, *Response, error) {
+	q := &Query{Filter: Eq("Business_Accounting_NO", input.BusinessAccountingNO)}
+	outputs := []CompanyManagerOutput{}
+
+	resp, err := s.client.Query(ctx, "9D17AE0D-09B5-4732-A8F4-81ADED04B679", q, &outputs)
+	if err != nil {
+		return nil, resp, err
+	}
+	return outputs, resp, nil
+}
+
+type CompanyByAddressInput struct {
+	CompanyLocation string
+	// Optional filter, left out of the query when empty.
+	CompanyStatus string
+
+	SearchOptions
+}
+
+// SearchByAddress searches the information of companies by registered address.
+func (s *CompanyService) SearchByAddress(ctx context.Context, input *CompanyByAddressInput) ([]CompanyByKeywordOutput, *Response, error) {
+	opts, err := input.SearchOptions.withDefaults()
+	if err != nil {
+		return nil, nil, err
+	}
+	filter := Like("Company_Location", input.CompanyLocation)
+	if input.CompanyStatus != "" {
+		filter = And(filter, Eq("Company_Status", input.CompanyStatus))
+	}
+	q := &Query{
+		Filter: filter,
+		Skip:   opts.Skip,
+		Top:    opts.Top,
+	}
+	outputs := []CompanyByKeywordOutput{}
+
+	resp, err := s.client.Query(ctx, "673F0FC0-B3A7-429F-9041-E9866836B66D", q, &outputs)
+	if err != nil {
+		return nil, resp, err
+	}
+	return outputs, resp, nil
+}
+
+type CompanyBySetupDateInput struct {
+	// Optional filters, left out of the query when zero.
+	SetupDateFrom        time.Time
+	SetupDateTo          time.Time
+	RegisterOrganization string
+
+	SearchOptions
+}
+
+// SearchBySetupDate searches the information of companies by setup date range and register organization.
+// At least one of the filters is required, so that it never scans the whole dataset.
+func (s *CompanyService) SearchBySetupDate(ctx context.Context, input *CompanyBySetupDateInput) ([]CompanyByKeywordOutput, *Response, error) {
+	opts, err := input.SearchOptions.withDefaults()
+	if err != nil {
+		return nil, nil, err
+	}
+	var filter Filter
+	if !input.SetupDateFrom.IsZero() {
+		filter = And(filter, Ge("Company_Setup_Date", FormatROCDate(input.SetupDateFrom)))
+	}
+	if !input.SetupDateTo.IsZero() {
+		filter = And(filter, Le("Company_Setup_Date", FormatROCDate(input.SetupDateTo)))
+	}
+	if input.RegisterOrganization != "" {
+		filter = And(filter, Eq("Register_Organization", input.RegisterOrganization))
+	}
+	if filter.expr == "" {
+		return nil, nil, errors.New("search by setup date requires SetupDateFrom, SetupDateTo or RegisterOrganization")
+	}
+	q := &Query{
+		Filter: filter,
+		Skip:   opts.Skip,
+		Top:    opts.Top,
+	}
+	outputs := []CompanyByKeywordOutput{}
+
+	resp, err := s.client.Query(ctx, "6BBA2268-1367-4B42-9CCA-BC17499EBE8C", q, &outputs)
+	if err != nil {
+		return nil, resp, err
+	}
+	return outputs, resp, nil
+}
+
+// Company status codes accepted by SearchByStatusChange, see CompanyStatusCodes.
+const (
+	CompanyStatusSuspended = "02"
+	CompanyStatusDissolved = "03"
+	CompanyStatusRevoked   = "04"
+)
+
+type CompanyByStatusChangeInput struct {
+	CompanyStatus string
+	// Optional filters, left out of the query when zero. Not supported for dissolved companies.
+	ChangeDateFrom time.Time
+	ChangeDateTo   time.Time
+
+	SearchOptions
+}
+
+// statusChangeDateFields are the date fields of company basic information matching the status codes, empty if
+// there is none.
+var statusChangeDateFields = map[string]string{
+	CompanyStatusSuspended: "Sus_App_Date",
+	CompanyStatusDissolved: "",
+	CompanyStatusRevoked:   "Revoke_App_Date",
+}
+
+// SearchByStatusChange searches the basic information of companies dissolved, revoked or suspended within a date range.
+// The dataset has the status as Company_Status_Desc only, so the status code is matched by its description in
+// CompanyStatusCodes. The range applies to Sus_App_Date for suspended companies and to Revoke_App_Date for revoked
+// ones; the dataset has no date of dissolution, so dissolved companies can not be searched by range.
+func (s *CompanyService) SearchByStatusChange(ctx context.Context, input *CompanyByStatusChangeInput) ([]CompanyBasicInformationOutput, *Response, error) {
+	opts, err := input.SearchOptions.withDefaults()
+	if err != nil {
+		return nil, nil, err
+	}
+	dateField, ok := statusChangeDateFields[input.CompanyStatus]
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported company status: %q", input.CompanyStatus)
+	}
+	hasRange := !input.ChangeDateFrom.IsZero() || !input.ChangeDateTo.IsZero()
+	if dateField == "" && hasRange {
+		return nil, nil, fmt.Errorf("company status %q has no change date to search by", input.CompanyStatus)
+	}
+	desc, _ := LookupCode(CompanyStatusCodes, input.CompanyStatus)
+	filter := Eq("Company_Status_Desc", desc)
+	if !input.ChangeDateFrom.IsZero() {
+		filter = And(filter, Ge(dateField, FormatROCDate(input.ChangeDateFrom)))
+	}
+	if !input.ChangeDateTo.IsZero() {
+		filter = And(filter, Le(dateField, FormatROCDate(input.ChangeDateTo)))
+	}
+	q := &Query{
+		Filter: filter,
+		Skip:   opts.Skip,
+		Top:    opts.Top,
 	}
-	u := fmt.Sprintf("od/data/api/4B61A0F1-458C-43F9-93F3-9FD6DA5E1B08?$format=json&$filter=Responsible_Name eq %s&$skip=%d&$top=%d",
-		input.ResponsibleName,
-		input.Skip,
-		input.Top)
-	outputs := make([]CompanyByResponsibleNameOutput, 1)
+	outputs := []CompanyBasicInformationOutput{}
 
-	resp, err := s.client.get(ctx, u, &outputs)
+	resp, err := s.client.Query(ctx, "5F64D864-61CB-4D0D-8AD9-492047CC1EA6", q, &outputs)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -2,8 +2,13 @@ package gcis
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/url"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 var (
@@ -78,6 +83,126 @@ func TestCompanyService_GetBasicInformation_notFound(t *testing.T) {
 	}
 }
 
+func TestCompanyService_GetBasicInformationBatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/od/data/api/5F64D864-61CB-4D0D-8AD9-492047CC1EA6", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, "$filter", "Business_Accounting_NO eq '20828393' or Business_Accounting_NO eq '00000000'")
+		testQuery(t, r, "$top", "2")
+
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		w.Write(companyBasicInformationJSON)
+	})
+
+	got, _, err := client.Company.GetBasicInformationBatch(context.Background(),
+		&CompanyBasicInformationBatchInput{
+			BusinessAccountingNOs: []string{"20828393", "00000000", "20828393"},
+		})
+	if err != nil {
+		t.Errorf("Company.GetBasicInformationBatch returned error: %v", err)
+	}
+	want := map[string]*CompanyBasicInformationOutput{
+		"20828393": companyBasicInformation,
+		"00000000": nil,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Company.GetBasicInformationBatch = %+v, want %+v", got, want)
+	}
+}
+
+func TestCompanyService_GetBasicInformationBatch_urlLength(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/od/data/api/5F64D864-61CB-4D0D-8AD9-492047CC1EA6", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if l := len(server.URL + r.URL.RequestURI()); l > maxURLLength {
+			t.Errorf("Request URL length: %v, want <= %v", l, maxURLLength)
+		}
+
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	})
+
+	var nos []string
+	for i := 0; i < 200; i++ {
+		nos = append(nos, fmt.Sprintf("%08d", i))
+	}
+	got, _, err := client.Company.GetBasicInformationBatch(context.Background(), &CompanyBasicInformationBatchInput{nos})
+	if err != nil {
+		t.Errorf("Company.GetBasicInformationBatch returned error: %v", err)
+	}
+	if len(got) != len(nos) {
+		t.Errorf("Company.GetBasicInformationBatch returned %v results, want %v", len(got), len(nos))
+	}
+	if requests < 2 {
+		t.Errorf("Company.GetBasicInformationBatch made %v requests, want at least 2", requests)
+	}
+}
+
+var companyBasicInformationXML = []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<ROOT>
+  <ROW>
+    <Business_Accounting_NO>20828393</Business_Accounting_NO>
+    <Company_Status_Desc>核准設立</Company_Status_Desc>
+    <Company_Name>宏碁股份有限公司</Company_Name>
+    <Capital_Stock_Amount>35000000000</Capital_Stock_Amount>
+    <Paid_In_Capital_Amount>30765028280</Paid_In_Capital_Amount>
+    <Responsible_Name>陳O聖</Responsible_Name>
+    <Company_Location>臺北市松山區民福里復興北路369號7樓之5</Company_Location>
+    <Register_Organization_Desc>經濟部商業司</Register_Organization_Desc>
+    <Company_Setup_Date>0680718</Company_Setup_Date>
+    <Change_Of_Approval_Data>1060905</Change_Of_Approval_Data>
+    <Revoke_App_Date></Revoke_App_Date>
+    <Case_Status></Case_Status>
+    <Case_Status_Desc></Case_Status_Desc>
+    <Sus_App_Date></Sus_App_Date>
+    <Sus_Beg_Date></Sus_Beg_Date>
+    <Sus_End_Date></Sus_End_Date>
+  </ROW>
+</ROOT>`)
+
+func TestCompanyService_GetBasicInformation_xml(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Format = FormatXML
+	mux.HandleFunc("/od/data/api/5F64D864-61CB-4D0D-8AD9-492047CC1EA6", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, "$format", "xml")
+
+		w.Header().Set("Content-Type", "application/xml;charset=UTF-8")
+		w.Write(companyBasicInformationXML)
+	})
+
+	got, _, err := client.Company.GetBasicInformation(context.Background(), &CompanyBasicInformationInput{"20828393"})
+	if err != nil {
+		t.Errorf("Company.GetBasicInformation returned error: %v", err)
+	}
+	if want := companyBasicInformation; !reflect.DeepEqual(got, want) {
+		t.Errorf("Company.GetBasicInformation = %+v, want %+v", got, want)
+	}
+}
+
+func TestCompanyService_GetBasicInformation_xmlNotFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Format = FormatXML
+	mux.HandleFunc("/od/data/api/5F64D864-61CB-4D0D-8AD9-492047CC1EA6", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml;charset=UTF-8")
+	})
+
+	got, _, err := client.Company.GetBasicInformation(context.Background(), &CompanyBasicInformationInput{})
+	if err != nil {
+		t.Errorf("Company.GetBasicInformation returned error: %v", err)
+	}
+	if got != nil {
+		t.Errorf("Company.GetBasicInformation = %+v, want nil", got)
+	}
+}
+
 var (
 	companyBasicInformationAndBusinessJSON = []byte(`[
   {
@@ -142,6 +267,39 @@ func TestCompanyService_GetBasicInformationAndBusiness_notFound(t *testing.T) {
 	}
 }
 
+func TestCompanyService_GetBasicInformationAndBusiness_xml(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Format = FormatXML
+	mux.HandleFunc("/od/data/api/236EE382-4942-41A9-BD03-CA0709025E7C", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml;charset=UTF-8")
+		w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<ROOT>
+  <ROW>
+    <Business_Accounting_NO>20828393</Business_Accounting_NO>
+    <Company_Name>宏碁股份有限公司</Company_Name>
+    <Company_Status>01</Company_Status>
+    <Company_Status_Desc>核准設立</Company_Status_Desc>
+    <Company_Setup_Date>0680718</Company_Setup_Date>
+    <Cmp_Business>
+      <Business_Seq_NO>0001</Business_Seq_NO>
+      <Business_Item>F113050</Business_Item>
+      <business_item_desc>電腦及事務性機器設備批發業</business_item_desc>
+    </Cmp_Business>
+  </ROW>
+</ROOT>`))
+	})
+
+	got, _, err := client.Company.GetBasicInformationAndBusiness(context.Background(), &CompanyBasicInformationInput{"20828393"})
+	if err != nil {
+		t.Errorf("Company.GetBasicInformationAndBusiness returned error: %v", err)
+	}
+	if want := companyBasicInformationAndBusiness; !reflect.DeepEqual(got, want) {
+		t.Errorf("Company.GetBasicInformationAndBusiness = %+v, want %+v", got, want)
+	}
+}
+
 var (
 	companyByKeywordJSON = []byte(`[
   {
@@ -197,6 +355,62 @@ func TestCompanyService_SearchByKeyword(t *testing.T) {
 	}
 }
 
+func TestCompanyService_SearchByKeywordPage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handle(t, "/od/data/api/6BBA2268-1367-4B42-9CCA-BC17499EBE8C", companyByKeywordJSON)
+	mux.HandleFunc("/od/data/api/6BBA2268-1367-4B42-9CCA-BC17499EBE8C/$count", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, "$filter", "Company_Name like '台灣積體電路製造股份有限公司' and Company_Status eq '01'")
+
+		w.Header().Set("Content-Type", "text/plain;charset=UTF-8")
+		w.Write([]byte("101"))
+	})
+
+	got, _, err := client.Company.SearchByKeywordPage(context.Background(),
+		&CompanyByKeywordInput{
+			CompanyName:   "台灣積體電路製造股份有限公司",
+			CompanyStatus: "01",
+			SearchOptions: SearchOptions{Skip: 100},
+		})
+	if err != nil {
+		t.Errorf("Company.SearchByKeywordPage returned error: %v", err)
+	}
+	want := &CompanyByKeywordPage{
+		Companies: companyByKeyword,
+		Skip:      100,
+		Top:       DefaultTop,
+		Total:     101,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Company.SearchByKeywordPage = %+v, want %+v", got, want)
+	}
+	if got, want := got.Page(), 3; got != want {
+		t.Errorf("CompanyByKeywordPage.Page = %v, want %v", got, want)
+	}
+	if got, want := got.Pages(), 3; got != want {
+		t.Errorf("CompanyByKeywordPage.Pages = %v, want %v", got, want)
+	}
+}
+
+func TestCompanyByKeywordPage_Pages(t *testing.T) {
+	tests := []struct {
+		total int
+		want  int
+	}{
+		{0, 1},
+		{1, 1},
+		{50, 1},
+		{51, 2},
+	}
+	for _, test := range tests {
+		p := &CompanyByKeywordPage{Top: 50, Total: test.total}
+		if got := p.Pages(); got != test.want {
+			t.Errorf("CompanyByKeywordPage{Total: %v}.Pages = %v, want %v", test.total, got, test.want)
+		}
+	}
+}
+
 func TestCompanyService_SearchByKeyword_notFound(t *testing.T) {
 	setup()
 	defer teardown()
@@ -216,6 +430,145 @@ func TestCompanyService_SearchByKeyword_notFound(t *testing.T) {
 	}
 }
 
+func TestCompanyService_StreamByKeyword(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handle(t, "/od/data/api/6BBA2268-1367-4B42-9CCA-BC17499EBE8C", companyByKeywordJSON)
+
+	var got []CompanyByKeywordOutput
+	_, err := client.Company.StreamByKeyword(context.Background(),
+		&CompanyByKeywordInput{
+			CompanyName:   "台灣積體電路製造股份有限公司",
+			CompanyStatus: "01",
+		}, func(output CompanyByKeywordOutput) error {
+			got = append(got, output)
+			return nil
+		})
+	if err != nil {
+		t.Errorf("Company.StreamByKeyword returned error: %v", err)
+	}
+	if want := companyByKeyword; !reflect.DeepEqual(got, want) {
+		t.Errorf("Company.StreamByKeyword = %+v, want %+v", got, want)
+	}
+}
+
+func TestCompanyService_StreamByKeyword_records(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handle(t, "/od/data/api/6BBA2268-1367-4B42-9CCA-BC17499EBE8C", []byte(`[
+  {"Business_Accounting_NO": "22099131"},
+  {"Business_Accounting_NO": "20828393"}
+]`))
+
+	var got []string
+	var outputs []CompanyByKeywordOutput
+	_, err := client.Company.StreamByKeyword(context.Background(), &CompanyByKeywordInput{}, func(output CompanyByKeywordOutput) error {
+		outputs = append(outputs, output)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Company.StreamByKeyword returned error: %v", err)
+	}
+	for _, output := range outputs {
+		got = append(got, output.BusinessAccountingNO)
+	}
+	if want := []string{"22099131", "20828393"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Company.StreamByKeyword = %v, want %v", got, want)
+	}
+}
+
+func TestCompanyService_StreamByKeyword_notFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handle(t, "/od/data/api/6BBA2268-1367-4B42-9CCA-BC17499EBE8C", nil)
+
+	_, err := client.Company.StreamByKeyword(context.Background(), &CompanyByKeywordInput{}, func(output CompanyByKeywordOutput) error {
+		t.Errorf("Company.StreamByKeyword called fn with %+v", output)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Company.StreamByKeyword returned error: %v", err)
+	}
+}
+
+func TestCompanyService_SearchByKeyword_defaultTop(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/od/data/api/6BBA2268-1367-4B42-9CCA-BC17499EBE8C", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, "$top", "50")
+
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	})
+
+	input := &CompanyByKeywordInput{CompanyName: "台積電"}
+	_, _, err := client.Company.SearchByKeyword(context.Background(), input)
+	if err != nil {
+		t.Errorf("Company.SearchByKeyword returned error: %v", err)
+	}
+	if input.Top != 0 {
+		t.Errorf("Company.SearchByKeyword modified input Top = %v, want 0", input.Top)
+	}
+}
+
+func TestCompanyService_SearchByKeyword_invalidSearchOptions(t *testing.T) {
+	_, _, err := NewClient().Company.SearchByKeyword(context.Background(),
+		&CompanyByKeywordInput{
+			CompanyName:   "台積電",
+			SearchOptions: SearchOptions{Top: MaxTop + 1},
+		})
+	if _, ok := err.(*SearchOptionsError); !ok {
+		t.Errorf("Company.SearchByKeyword returned error: %v, want *SearchOptionsError", err)
+	}
+}
+
+func TestCompanyService_SearchByKeyword_unusualInput(t *testing.T) {
+	inputs := []string{
+		"A&B",
+		"A&$top=1",
+		"#1",
+		"100%",
+		"100%25",
+		"台積　電",
+		"a+b",
+		"O'Reilly",
+		"x' and Company_Status eq '03",
+		"x and Company_Status eq 03",
+	}
+
+	for _, input := range inputs {
+		setup()
+
+		mux.HandleFunc("/od/data/api/6BBA2268-1367-4B42-9CCA-BC17499EBE8C", func(w http.ResponseWriter, r *http.Request) {
+			want := url.Values{
+				"$format": {"json"},
+				"$filter": {"Company_Name like '" + strings.Replace(input, "'", "''", -1) + "' and Company_Status eq '01'"},
+				"$skip":   {"0"},
+				"$top":    {"50"},
+			}
+			if got := r.URL.Query(); !reflect.DeepEqual(got, want) {
+				t.Errorf("Request query for %q: %v, want %v", input, got, want)
+			}
+
+			w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		})
+
+		_, _, err := client.Company.SearchByKeyword(context.Background(),
+			&CompanyByKeywordInput{
+				CompanyName:   input,
+				CompanyStatus: "01",
+			})
+		if err != nil {
+			t.Errorf("Company.SearchByKeyword(%q) returned error: %v", input, err)
+		}
+
+		teardown()
+	}
+}
+
 var (
 	companyByResponsibleNameJSON = []byte(`[
   {
@@ -249,3 +602,253 @@ func TestCompanyService_SearchByResponsibleName(t *testing.T) {
 		t.Errorf("Company.SearchByResponsibleName = %+v, want %+v", got, want)
 	}
 }
+
+var (
+	companyManagersJSON = []byte(`[
+  {
+    "Sequence_No": "0001",
+    "Name": "陳O聖",
+    "Arrival_Date": "1020101"
+  },
+  {
+    "Sequence_No": "0002",
+    "Name": "高O國",
+    "Arrival_Date": "1050301"
+  }
+]`)
+
+	companyManagers = []CompanyManagerOutput{
+		{
+			SequenceNO:  "0001",
+			Name:        "陳O聖",
+			ArrivalDate: "1020101",
+		},
+		{
+			SequenceNO:  "0002",
+			Name:        "高O國",
+			ArrivalDate: "1050301",
+		},
+	}
+)
+
+func TestCompanyService_GetManagers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handle(t, "/od/data/api/9D17AE0D-09B5-4732-A8F4-81ADED04B679", companyManagersJSON)
+
+	got, _, err := client.Company.GetManagers(context.Background(), &CompanyBasicInformationInput{"20828393"})
+	if err != nil {
+		t.Errorf("Company.GetManagers returned error: %v", err)
+	}
+	if want := companyManagers; !reflect.DeepEqual(got, want) {
+		t.Errorf("Company.GetManagers = %+v, want %+v", got, want)
+	}
+}
+
+func TestCompanyService_GetManagers_notFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handle(t, "/od/data/api/9D17AE0D-09B5-4732-A8F4-81ADED04B679", nil)
+
+	got, _, err := client.Company.GetManagers(context.Background(), &CompanyBasicInformationInput{})
+	if err != nil {
+		t.Errorf("Company.GetManagers returned error: %v", err)
+	}
+	if want := []CompanyManagerOutput{}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Company.GetManagers = %+v, want %+v", got, want)
+	}
+}
+
+func TestCompanyService_GetManagers_emptyChunked(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handleChunked(t, "/od/data/api/9D17AE0D-09B5-4732-A8F4-81ADED04B679", nil)
+
+	got, _, err := client.Company.GetManagers(context.Background(), &CompanyBasicInformationInput{})
+	if err != nil {
+		t.Errorf("Company.GetManagers returned error: %v", err)
+	}
+	if want := []CompanyManagerOutput{}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Company.GetManagers = %+v, want %+v", got, want)
+	}
+}
+
+func TestCompanyService_GetBasicInformation_emptyChunked(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handleChunked(t, "/od/data/api/5F64D864-61CB-4D0D-8AD9-492047CC1EA6", nil)
+
+	got, _, err := client.Company.GetBasicInformation(context.Background(), &CompanyBasicInformationInput{})
+	if err != nil {
+		t.Errorf("Company.GetBasicInformation returned error: %v", err)
+	}
+	if got != nil {
+		t.Errorf("Company.GetBasicInformation = %+v, want nil", got)
+	}
+}
+
+func TestCompanyService_SearchByKeyword_emptyChunked(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handleChunked(t, "/od/data/api/6BBA2268-1367-4B42-9CCA-BC17499EBE8C", nil)
+
+	got, _, err := client.Company.SearchByKeyword(context.Background(), &CompanyByKeywordInput{})
+	if err != nil {
+		t.Errorf("Company.SearchByKeyword returned error: %v", err)
+	}
+	if want := []CompanyByKeywordOutput{}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Company.SearchByKeyword = %+v, want %+v", got, want)
+	}
+}
+
+func TestCompanyService_SearchByAddress(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/od/data/api/673F0FC0-B3A7-429F-9041-E9866836B66D", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, "$filter", "Company_Location like '新竹市力行六路8號' and Company_Status eq '01'")
+		testQuery(t, r, "$skip", "0")
+		testQuery(t, r, "$top", "50")
+
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		w.Write(companyByKeywordJSON)
+	})
+
+	got, _, err := client.Company.SearchByAddress(context.Background(),
+		&CompanyByAddressInput{
+			CompanyLocation: "新竹市力行六路8號",
+			CompanyStatus:   "01",
+		})
+	if err != nil {
+		t.Errorf("Company.SearchByAddress returned error: %v", err)
+	}
+	if want := companyByKeyword; !reflect.DeepEqual(got, want) {
+		t.Errorf("Company.SearchByAddress = %+v, want %+v", got, want)
+	}
+}
+
+func TestCompanyService_SearchByAddress_notFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handle(t, "/od/data/api/673F0FC0-B3A7-429F-9041-E9866836B66D", nil)
+
+	got, _, err := client.Company.SearchByAddress(context.Background(), &CompanyByAddressInput{CompanyLocation: "新竹市力行六路8號"})
+	if err != nil {
+		t.Errorf("Company.SearchByAddress returned error: %v", err)
+	}
+	if want := []CompanyByKeywordOutput{}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Company.SearchByAddress = %+v, want %+v", got, want)
+	}
+}
+
+func TestCompanyService_SearchBySetupDate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/od/data/api/6BBA2268-1367-4B42-9CCA-BC17499EBE8C", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, "$filter", "Company_Setup_Date ge '0760201' and Company_Setup_Date le '0760228' and Register_Organization eq '05'")
+
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		w.Write(companyByKeywordJSON)
+	})
+
+	got, _, err := client.Company.SearchBySetupDate(context.Background(),
+		&CompanyBySetupDateInput{
+			SetupDateFrom:        time.Date(1987, 2, 1, 0, 0, 0, 0, time.UTC),
+			SetupDateTo:          time.Date(1987, 2, 28, 0, 0, 0, 0, time.UTC),
+			RegisterOrganization: "05",
+		})
+	if err != nil {
+		t.Errorf("Company.SearchBySetupDate returned error: %v", err)
+	}
+	if want := companyByKeyword; !reflect.DeepEqual(got, want) {
+		t.Errorf("Company.SearchBySetupDate = %+v, want %+v", got, want)
+	}
+}
+
+func TestCompanyService_SearchBySetupDate_noFilter(t *testing.T) {
+	_, _, err := NewClient().Company.SearchBySetupDate(context.Background(), &CompanyBySetupDateInput{})
+	if err == nil {
+		t.Errorf("Company.SearchBySetupDate expected error")
+	}
+}
+
+func TestCompanyService_SearchByStatusChange(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/od/data/api/5F64D864-61CB-4D0D-8AD9-492047CC1EA6", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, "$filter", "Company_Status_Desc eq '停業' and Sus_App_Date ge '1080101' and Sus_App_Date le '1080131'")
+
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		w.Write(companyBasicInformationJSON)
+	})
+
+	got, _, err := client.Company.SearchByStatusChange(context.Background(),
+		&CompanyByStatusChangeInput{
+			CompanyStatus:  CompanyStatusSuspended,
+			ChangeDateFrom: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+			ChangeDateTo:   time.Date(2019, 1, 31, 0, 0, 0, 0, time.UTC),
+		})
+	if err != nil {
+		t.Errorf("Company.SearchByStatusChange returned error: %v", err)
+	}
+	if want := []CompanyBasicInformationOutput{*companyBasicInformation}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Company.SearchByStatusChange = %+v, want %+v", got, want)
+	}
+}
+
+func TestCompanyStatusConstants(t *testing.T) {
+	tests := map[string]string{
+		CompanyStatusSuspended: "停業",
+		CompanyStatusDissolved: "解散",
+		CompanyStatusRevoked:   "撤銷",
+	}
+	for code, want := range tests {
+		if got, _ := LookupCode(CompanyStatusCodes, code); got != want {
+			t.Errorf("LookupCode(CompanyStatusCodes, %q) = %v, want %v", code, got, want)
+		}
+	}
+}
+
+func TestCompanyService_SearchByStatusChange_dissolved(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/od/data/api/5F64D864-61CB-4D0D-8AD9-492047CC1EA6", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, "$filter", "Company_Status_Desc eq '解散'")
+
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		w.Write(companyBasicInformationJSON)
+	})
+
+	_, _, err := client.Company.SearchByStatusChange(context.Background(), &CompanyByStatusChangeInput{CompanyStatus: CompanyStatusDissolved})
+	if err != nil {
+		t.Errorf("Company.SearchByStatusChange returned error: %v", err)
+	}
+
+	_, _, err = client.Company.SearchByStatusChange(context.Background(),
+		&CompanyByStatusChangeInput{
+			CompanyStatus:  CompanyStatusDissolved,
+			ChangeDateFrom: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+		})
+	if err == nil {
+		t.Errorf("Company.SearchByStatusChange expected error for dissolved companies by date")
+	}
+}
+
+func TestCompanyService_SearchByStatusChange_unsupportedStatus(t *testing.T) {
+	_, _, err := NewClient().Company.SearchByStatusChange(context.Background(), &CompanyByStatusChangeInput{CompanyStatus: "01"})
+	if err == nil {
+		t.Errorf("Company.SearchByStatusChange expected error")
+	}
+}
@@ -0,0 +1,83 @@
+package gcis
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// Dataset is a handle of GCIS dataset identified by GUID, for datasets which are not wrapped by the library yet.
+type Dataset struct {
+	client *Client
+
+	ID string
+}
+
+// Dataset returns the handle of dataset by id, e.g. 5F64D864-61CB-4D0D-8AD9-492047CC1EA6.
+func (c *Client) Dataset(id string) *Dataset {
+	return &Dataset{client: c, ID: id}
+}
+
+// Get fetches the first record matching the query into v, a pointer to struct or map (JSON only), and reports
+// whether it was found.
+func (d *Dataset) Get(ctx context.Context, q *Query, v interface{}) (bool, *Response, error) {
+	if err := checkPointer(v); err != nil {
+		return false, nil, err
+	}
+	var query Query
+	if q != nil {
+		query = *q
+	}
+	if query.Top == 0 {
+		query.Top = 1
+	}
+	records := reflect.New(reflect.SliceOf(reflect.TypeOf(v).Elem()))
+
+	resp, err := d.client.Query(ctx, d.ID, &query, records.Interface())
+	if err != nil {
+		return false, resp, err
+	}
+	if records.Elem().Len() == 0 {
+		return false, resp, nil
+	}
+	reflect.ValueOf(v).Elem().Set(records.Elem().Index(0))
+	return true, resp, nil
+}
+
+// List fetches the records matching the query into v, a pointer to slice of struct or map (JSON only).
+func (d *Dataset) List(ctx context.Context, q *Query, v interface{}) (*Response, error) {
+	if q == nil {
+		q = &Query{}
+	}
+	return d.client.Query(ctx, d.ID, q, v)
+}
+
+// Stream fetches the records matching the query one at a time into v, a pointer to struct, and calls fn after each
+// record is decoded, so that large results are processed with constant memory. v is overwritten by the next record,
+// copy it to keep the record. Returning ErrStopStream from fn stops the stream without error.
+func (d *Dataset) Stream(ctx context.Context, q *Query, v interface{}, fn func() error) (*Response, error) {
+	if err := checkPointer(v); err != nil {
+		return nil, err
+	}
+	if q == nil {
+		q = &Query{}
+	}
+	return d.client.Query(ctx, d.ID, q, newRecordStream(v, fn))
+}
+
+// checkPointer checks that v is a non-nil pointer which records can be decoded into.
+func checkPointer(v interface{}) error {
+	if rv := reflect.ValueOf(v); rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("invalid record type %T, want non-nil pointer", v)
+	}
+	return nil
+}
+
+// Export streams the raw response of the query into w, e.g. for archiving with Query.Format set to FormatCSV.
+func (d *Dataset) Export(ctx context.Context, q *Query, w io.Writer) (*Response, error) {
+	if q == nil {
+		q = &Query{}
+	}
+	return d.client.Query(ctx, d.ID, q, w)
+}
@@ -0,0 +1,237 @@
+package gcis
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestDataset_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handle(t, "/od/data/api/5F64D864-61CB-4D0D-8AD9-492047CC1EA6", companyBasicInformationJSON)
+
+	got := new(CompanyBasicInformationOutput)
+	found, _, err := client.Dataset("5F64D864-61CB-4D0D-8AD9-492047CC1EA6").Get(context.Background(),
+		&Query{Filter: Eq("Business_Accounting_NO", "20828393")}, got)
+	if err != nil {
+		t.Errorf("Dataset.Get returned error: %v", err)
+	}
+	if !found {
+		t.Errorf("Dataset.Get found = false, want true")
+	}
+	if want := companyBasicInformation; !reflect.DeepEqual(got, want) {
+		t.Errorf("Dataset.Get = %+v, want %+v", got, want)
+	}
+}
+
+func TestDataset_Get_notFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handle(t, "/od/data/api/5F64D864-61CB-4D0D-8AD9-492047CC1EA6", nil)
+
+	var got map[string]interface{}
+	found, _, err := client.Dataset("5F64D864-61CB-4D0D-8AD9-492047CC1EA6").Get(context.Background(), nil, &got)
+	if err != nil {
+		t.Errorf("Dataset.Get returned error: %v", err)
+	}
+	if found {
+		t.Errorf("Dataset.Get found = true, want false")
+	}
+	if got != nil {
+		t.Errorf("Dataset.Get = %+v, want nil", got)
+	}
+}
+
+func TestDataset_Get_invalid(t *testing.T) {
+	var output *CompanyBasicInformationOutput
+	for _, v := range []interface{}{nil, CompanyBasicInformationOutput{}, output} {
+		_, _, err := NewClient().Dataset("5F64D864-61CB-4D0D-8AD9-492047CC1EA6").Get(context.Background(), nil, v)
+		if err == nil {
+			t.Errorf("Dataset.Get(%#v) expected error", v)
+		}
+	}
+}
+
+func TestDataset_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handle(t, "/od/data/api/4B61A0F1-458C-43F9-93F3-9FD6DA5E1B08", companyByResponsibleNameJSON)
+
+	var got []map[string]interface{}
+	_, err := client.Dataset("4B61A0F1-458C-43F9-93F3-9FD6DA5E1B08").List(context.Background(),
+		&Query{Filter: Eq("Responsible_Name", "劉德音"), Top: 50}, &got)
+	if err != nil {
+		t.Errorf("Dataset.List returned error: %v", err)
+	}
+	want := []map[string]interface{}{
+		{
+			"Business_Accounting_NO": "22099131",
+			"Company_Name":           "台灣積體電路製造股份有限公司",
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Dataset.List = %+v, want %+v", got, want)
+	}
+}
+
+func TestDataset_Export(t *testing.T) {
+	setup()
+	defer teardown()
+
+	csv := "Business_Accounting_NO,Company_Name\n22099131,台灣積體電路製造股份有限公司\n"
+	mux.HandleFunc("/od/data/api/4B61A0F1-458C-43F9-93F3-9FD6DA5E1B08", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, "$format", "csv")
+
+		w.Header().Set("Content-Type", "text/csv;charset=UTF-8")
+		w.Write([]byte(csv))
+	})
+
+	var buf bytes.Buffer
+	_, err := client.Dataset("4B61A0F1-458C-43F9-93F3-9FD6DA5E1B08").Export(context.Background(),
+		&Query{Filter: Eq("Responsible_Name", "劉德音"), Format: FormatCSV}, &buf)
+	if err != nil {
+		t.Errorf("Dataset.Export returned error: %v", err)
+	}
+	if got := buf.String(); got != csv {
+		t.Errorf("Dataset.Export = %v, want %v", got, csv)
+	}
+}
+
+type errWriter struct{ err error }
+
+func (w errWriter) Write(p []byte) (int, error) {
+	return 0, w.err
+}
+
+func TestDataset_Export_writeError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/od/data/api/4B61A0F1-458C-43F9-93F3-9FD6DA5E1B08", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/csv;charset=UTF-8")
+		w.Write([]byte("Business_Accounting_NO\n22099131\n"))
+	})
+
+	want := errors.New("disk full")
+	_, err := client.Dataset("4B61A0F1-458C-43F9-93F3-9FD6DA5E1B08").Export(context.Background(),
+		&Query{Format: FormatCSV}, errWriter{want})
+	if err != want {
+		t.Errorf("Dataset.Export returned error: %v, want %v", err, want)
+	}
+}
+
+func TestDataset_List_csv(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/od/data/api/4B61A0F1-458C-43F9-93F3-9FD6DA5E1B08", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Dataset.List sent request with CSV format")
+	})
+	client.Format = FormatCSV
+
+	var outputs []map[string]interface{}
+	_, err := client.Dataset("4B61A0F1-458C-43F9-93F3-9FD6DA5E1B08").List(context.Background(), &Query{}, &outputs)
+	if err == nil {
+		t.Errorf("Dataset.List expected error")
+	}
+}
+
+func TestDataset_Stream(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handle(t, "/od/data/api/4B61A0F1-458C-43F9-93F3-9FD6DA5E1B08", []byte(`[
+  {"Business_Accounting_NO": "22099131", "Company_Name": "台灣積體電路製造股份有限公司"},
+  {"Business_Accounting_NO": "20828393"}
+]`))
+
+	var got []CompanyByResponsibleNameOutput
+	output := new(CompanyByResponsibleNameOutput)
+	_, err := client.Dataset("4B61A0F1-458C-43F9-93F3-9FD6DA5E1B08").Stream(context.Background(), nil, output, func() error {
+		got = append(got, *output)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Dataset.Stream returned error: %v", err)
+	}
+	want := []CompanyByResponsibleNameOutput{
+		{BusinessAccountingNO: "22099131", CompanyName: "台灣積體電路製造股份有限公司"},
+		{BusinessAccountingNO: "20828393"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Dataset.Stream = %+v, want %+v", got, want)
+	}
+}
+
+func TestDataset_Stream_invalid(t *testing.T) {
+	_, err := NewClient().Dataset("5F64D864-61CB-4D0D-8AD9-492047CC1EA6").Stream(context.Background(), nil, nil, func() error {
+		return nil
+	})
+	if err == nil {
+		t.Errorf("Dataset.Stream expected error")
+	}
+}
+
+func TestDataset_Stream_stop(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handle(t, "/od/data/api/4B61A0F1-458C-43F9-93F3-9FD6DA5E1B08", []byte(`[{}, {}, {}]`))
+
+	n := 0
+	_, err := client.Dataset("4B61A0F1-458C-43F9-93F3-9FD6DA5E1B08").Stream(context.Background(), nil, new(CompanyByResponsibleNameOutput), func() error {
+		n++
+		return ErrStopStream
+	})
+	if err != nil {
+		t.Errorf("Dataset.Stream returned error: %v", err)
+	}
+	if n != 1 {
+		t.Errorf("Dataset.Stream called fn %v times, want 1", n)
+	}
+}
+
+func TestDataset_Stream_error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handle(t, "/od/data/api/4B61A0F1-458C-43F9-93F3-9FD6DA5E1B08", []byte(`[{}, {}]`))
+
+	want := errors.New("fn error")
+	_, err := client.Dataset("4B61A0F1-458C-43F9-93F3-9FD6DA5E1B08").Stream(context.Background(), nil, new(CompanyByResponsibleNameOutput), func() error {
+		return want
+	})
+	if err != want {
+		t.Errorf("Dataset.Stream returned error: %v, want %v", err, want)
+	}
+}
+
+func TestDataset_Stream_xml(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/od/data/api/5F64D864-61CB-4D0D-8AD9-492047CC1EA6", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml;charset=UTF-8")
+		w.Write(companyBasicInformationXML)
+	})
+
+	var got []CompanyBasicInformationOutput
+	output := new(CompanyBasicInformationOutput)
+	_, err := client.Dataset("5F64D864-61CB-4D0D-8AD9-492047CC1EA6").Stream(context.Background(), &Query{Format: FormatXML}, output, func() error {
+		got = append(got, *output)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Dataset.Stream returned error: %v", err)
+	}
+	if want := []CompanyBasicInformationOutput{*companyBasicInformation}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Dataset.Stream = %+v, want %+v", got, want)
+	}
+}
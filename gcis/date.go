@@ -0,0 +1,31 @@
+package gcis
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// rocYearOffset is the difference between the Gregorian and the ROC (Minguo) calendar year.
+const rocYearOffset = 1911
+
+// FormatROCDate formats t as the ROC calendar date used by GCIS, e.g. 2008-07-18 as "0970718".
+func FormatROCDate(t time.Time) string {
+	return fmt.Sprintf("%03d%02d%02d", t.Year()-rocYearOffset, t.Month(), t.Day())
+}
+
+// ParseROCDate parses the ROC calendar date used by GCIS, e.g. "0970718" as 2008-07-18 UTC.
+func ParseROCDate(s string) (time.Time, error) {
+	if len(s) != 7 {
+		return time.Time{}, fmt.Errorf("invalid ROC date %q", s)
+	}
+	year, err := strconv.Atoi(s[:3])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid ROC date %q", s)
+	}
+	t, err := time.Parse("0102", s[3:])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid ROC date %q", s)
+	}
+	return time.Date(year+rocYearOffset, t.Month(), t.Day(), 0, 0, 0, 0, time.UTC), nil
+}
@@ -0,0 +1,38 @@
+package gcis
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatROCDate(t *testing.T) {
+	tests := []struct {
+		in   time.Time
+		want string
+	}{
+		{time.Date(1979, 7, 18, 0, 0, 0, 0, time.UTC), "0680718"},
+		{time.Date(2018, 11, 28, 23, 59, 0, 0, time.UTC), "1071128"},
+	}
+
+	for i, test := range tests {
+		if got := FormatROCDate(test.in); got != test.want {
+			t.Errorf("(%v) FormatROCDate = %v, want %v", i, got, test.want)
+		}
+	}
+}
+
+func TestParseROCDate(t *testing.T) {
+	got, err := ParseROCDate("0760221")
+	if err != nil {
+		t.Errorf("ParseROCDate returned error: %v", err)
+	}
+	if want := time.Date(1987, 2, 21, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("ParseROCDate = %v, want %v", got, want)
+	}
+
+	for _, in := range []string{"", "760221", "07602210", "0761321", "abc0221"} {
+		if _, err := ParseROCDate(in); err == nil {
+			t.Errorf("ParseROCDate(%q) expected error", in)
+		}
+	}
+}
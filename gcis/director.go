@@ -0,0 +1,29 @@
+package gcis
+
+import "context"
+
+type DirectorService service
+
+type DirectorListInput struct {
+	BusinessAccountingNO string
+}
+
+type DirectorOutput struct {
+	Seq                string `json:"Seq" xml:"Seq"`
+	PersonPositionName string `json:"Person_Position_Name" xml:"Person_Position_Name"`
+	PersonName         string `json:"Person_Name" xml:"Person_Name"`
+	JuristicPersonName string `json:"Juristic_Person_Name" xml:"Juristic_Person_Name"`
+	PersonShareholding int64  `json:"Person_Shareholding" xml:"Person_Shareholding"`
+}
+
+// List lists the directors and supervisors of company by accounting no.
+func (s *DirectorService) List(ctx context.Context, input *DirectorListInput) ([]DirectorOutput, *Response, error) {
+	q := &Query{Filter: Eq("Business_Accounting_NO", input.BusinessAccountingNO)}
+	outputs := []DirectorOutput{}
+
+	resp, err := s.client.Query(ctx, "4E5F7653-1B91-4DDC-99D5-468530FAE396", q, &outputs)
+	if err != nil {
+		return nil, resp, err
+	}
+	return outputs, resp, nil
+}
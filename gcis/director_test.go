@@ -0,0 +1,88 @@
+package gcis
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+var (
+	directorListJSON = []byte(`[
+  {
+    "Seq": "0001",
+    "Person_Position_Name": "董事長",
+    "Person_Name": "陳O聖",
+    "Juristic_Person_Name": "",
+    "Person_Shareholding": 2814714
+  },
+  {
+    "Seq": "0002",
+    "Person_Position_Name": "董事",
+    "Person_Name": "黃O華",
+    "Juristic_Person_Name": "",
+    "Person_Shareholding": 7412318
+  }
+]`)
+
+	directorList = []DirectorOutput{
+		{
+			Seq:                "0001",
+			PersonPositionName: "董事長",
+			PersonName:         "陳O聖",
+			JuristicPersonName: "",
+			PersonShareholding: 2814714,
+		},
+		{
+			Seq:                "0002",
+			PersonPositionName: "董事",
+			PersonName:         "黃O華",
+			JuristicPersonName: "",
+			PersonShareholding: 7412318,
+		},
+	}
+)
+
+func TestDirectorService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handle(t, "/od/data/api/4E5F7653-1B91-4DDC-99D5-468530FAE396", directorListJSON)
+
+	got, _, err := client.Director.List(context.Background(), &DirectorListInput{"20828393"})
+	if err != nil {
+		t.Errorf("Director.List returned error: %v", err)
+	}
+	if want := directorList; !reflect.DeepEqual(got, want) {
+		t.Errorf("Director.List = %+v, want %+v", got, want)
+	}
+}
+
+func TestDirectorService_List_notFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handle(t, "/od/data/api/4E5F7653-1B91-4DDC-99D5-468530FAE396", nil)
+
+	got, _, err := client.Director.List(context.Background(), &DirectorListInput{})
+	if err != nil {
+		t.Errorf("Director.List returned error: %v", err)
+	}
+	if want := []DirectorOutput{}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Director.List = %+v, want %+v", got, want)
+	}
+}
+
+func TestDirectorService_List_emptyChunked(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handleChunked(t, "/od/data/api/4E5F7653-1B91-4DDC-99D5-468530FAE396", nil)
+
+	got, _, err := client.Director.List(context.Background(), &DirectorListInput{})
+	if err != nil {
+		t.Errorf("Director.List returned error: %v", err)
+	}
+	if want := []DirectorOutput{}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Director.List = %+v, want %+v", got, want)
+	}
+}
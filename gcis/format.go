@@ -0,0 +1,79 @@
+package gcis
+
+import (
+	"encoding/xml"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// Response formats of GCIS API, set by Client.Format or Query.Format.
+const (
+	FormatJSON = "json"
+	FormatXML  = "xml"
+	// FormatCSV can only be streamed into io.Writer, e.g. with Dataset.Export.
+	FormatCSV = "csv"
+)
+
+// contentTypes are the content types of API responses accepted by CheckResponse.
+var contentTypes = []string{
+	"application/json",
+	"application/xml",
+	"text/xml",
+	"text/csv",
+}
+
+func isXML(contentType string) bool {
+	return strings.HasPrefix(contentType, "application/xml") || strings.HasPrefix(contentType, "text/xml")
+}
+
+// decodeXML decodes the XML document into v. When v points to slice, every child element of the root element
+// is decoded as an element of the slice, the same way a JSON array is.
+func decodeXML(r io.Reader, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Slice {
+		err := xml.NewDecoder(r).Decode(v)
+		if err == io.EOF {
+			err = nil // ignore EOF errors caused by empty response body
+		}
+		return err
+	}
+	slice := rv.Elem()
+	slice.Set(reflect.MakeSlice(slice.Type(), 0, 0))
+
+	return eachXMLElement(r, func(dec *xml.Decoder, start *xml.StartElement) error {
+		elem := reflect.New(slice.Type().Elem())
+		if err := dec.DecodeElement(elem.Interface(), start); err != nil {
+			return err
+		}
+		slice.Set(reflect.Append(slice, elem.Elem()))
+		return nil
+	})
+}
+
+// eachXMLElement calls fn with every child element of the root element, fn must consume the element.
+func eachXMLElement(r io.Reader, fn func(dec *xml.Decoder, start *xml.StartElement) error) error {
+	dec := xml.NewDecoder(r)
+	depth := 0
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if depth == 1 {
+				if err := fn(dec, &t); err != nil {
+					return err
+				}
+				continue
+			}
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+	}
+}
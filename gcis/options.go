@@ -0,0 +1,80 @@
+package gcis
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Option configures a Client created by NewClient.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used to send API requests, http.DefaultClient by default.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.HTTPClient = httpClient
+	}
+}
+
+// WithBaseURL sets the base URL of API requests, a trailing slash is added to its path if missing.
+func WithBaseURL(baseURL *url.URL) Option {
+	return func(c *Client) {
+		u := *baseURL
+		if !strings.HasSuffix(u.Path, "/") {
+			u.Path += "/"
+		}
+		c.BaseURL = &u
+	}
+}
+
+// WithUserAgent sets the User-Agent header of API requests.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.UserAgent = userAgent
+	}
+}
+
+// WithFormat sets the response format requested when Query.Format is empty, see Client.Format.
+func WithFormat(format string) Option {
+	return func(c *Client) {
+		c.Format = format
+	}
+}
+
+// WithTimeout limits the time of each API request, including reading the response body.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// WithRateLimit limits the client to send at most n requests per period, evenly spaced.
+// Responses served from the cache are not limited.
+func WithRateLimit(n int, per time.Duration) Option {
+	return func(c *Client) {
+		if n > 0 {
+			c.limiter = &rateLimiter{interval: per / time.Duration(n)}
+		}
+	}
+}
+
+// WithCache caches the successful responses of GET requests by URL. The whole response is read into memory
+// before being cached, so streaming methods lose their constant memory usage.
+func WithCache(cache Cache) Option {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+// Logger logs the API requests, *log.Logger satisfies it.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// WithLogger logs every API request along with its status and duration.
+func WithLogger(logger Logger) Option {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
@@ -0,0 +1,165 @@
+package gcis
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewClient_options(t *testing.T) {
+	httpClient := &http.Client{}
+	baseURL, _ := url.Parse("http://localhost/")
+
+	c := NewClient(
+		WithHTTPClient(httpClient),
+		WithBaseURL(baseURL),
+		WithUserAgent("test"),
+		WithFormat(FormatXML),
+	)
+
+	if got, want := c.HTTPClient, httpClient; got != want {
+		t.Errorf("NewClient HTTPClient is %v, want %v", got, want)
+	}
+	if got, want := c.BaseURL.String(), baseURL.String(); got != want {
+		t.Errorf("NewClient BaseURL is %v, want %v", got, want)
+	}
+	if got, want := c.UserAgent, "test"; got != want {
+		t.Errorf("NewClient UserAgent is %v, want %v", got, want)
+	}
+	if got, want := c.Format, FormatXML; got != want {
+		t.Errorf("NewClient Format is %v, want %v", got, want)
+	}
+	if c.Company == nil {
+		t.Errorf("NewClient Company is nil")
+	}
+}
+
+func TestWithBaseURL(t *testing.T) {
+	tests := []struct {
+		baseURL string
+		want    string
+	}{
+		{"http://localhost", "http://localhost/"},
+		{"http://localhost/gcis", "http://localhost/gcis/"},
+		{"http://localhost/gcis/", "http://localhost/gcis/"},
+	}
+	for _, test := range tests {
+		u, _ := url.Parse(test.baseURL)
+		c := NewClient(WithBaseURL(u))
+		if got := c.BaseURL.String(); got != test.want {
+			t.Errorf("WithBaseURL(%v) BaseURL is %v, want %v", test.baseURL, got, test.want)
+		}
+		if got := u.String(); got != test.baseURL {
+			t.Errorf("WithBaseURL(%v) modified the URL to %v", test.baseURL, got)
+		}
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	setup()
+	defer teardown()
+
+	done := make(chan struct{})
+	defer close(done)
+	mux.HandleFunc("/od/data/api/4E5F7653-1B91-4DDC-99D5-468530FAE396", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-r.Context().Done():
+		}
+	})
+	WithTimeout(10 * time.Millisecond)(client)
+
+	_, _, err := client.Director.List(context.Background(), &DirectorListInput{"20828393"})
+	if err != context.DeadlineExceeded {
+		t.Errorf("Director.List returned error: %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestWithRateLimit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handle(t, "/od/data/api/4E5F7653-1B91-4DDC-99D5-468530FAE396", directorListJSON)
+	WithRateLimit(1, 20*time.Millisecond)(client)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, _, err := client.Director.List(context.Background(), &DirectorListInput{"20828393"}); err != nil {
+			t.Errorf("Director.List returned error: %v", err)
+		}
+	}
+	if got, want := time.Since(start), 40*time.Millisecond; got < want {
+		t.Errorf("Director.List took %v for 3 requests, want at least %v", got, want)
+	}
+}
+
+func TestWithCache(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/od/data/api/4E5F7653-1B91-4DDC-99D5-468530FAE396", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		w.Write(directorListJSON)
+	})
+	WithCache(NewMemoryCache())(client)
+
+	for i := 0; i < 2; i++ {
+		got, _, err := client.Director.List(context.Background(), &DirectorListInput{"20828393"})
+		if err != nil {
+			t.Errorf("Director.List returned error: %v", err)
+		}
+		if want := directorList; !reflect.DeepEqual(got, want) {
+			t.Errorf("Director.List = %+v, want %+v", got, want)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("Director.List sent %v requests, want 1", requests)
+	}
+}
+
+func TestWithCache_error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/od/data/api/4E5F7653-1B91-4DDC-99D5-468530FAE396", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	WithCache(NewMemoryCache())(client)
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.Director.List(context.Background(), &DirectorListInput{"20828393"}); err == nil {
+			t.Errorf("Director.List returned no error")
+		}
+	}
+	if requests != 2 {
+		t.Errorf("Director.List sent %v requests, want 2", requests)
+	}
+}
+
+func TestWithLogger(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handle(t, "/od/data/api/4E5F7653-1B91-4DDC-99D5-468530FAE396", directorListJSON)
+	buf := new(bytes.Buffer)
+	WithLogger(log.New(buf, "", 0))(client)
+
+	client.Director.List(context.Background(), &DirectorListInput{"20828393"})
+
+	if got, want := buf.String(), "GET "+server.URL+"/od/data/api/4E5F7653-1B91-4DDC-99D5-468530FAE396?"; !strings.HasPrefix(got, want) {
+		t.Errorf("Logger output is %q, want prefix %q", got, want)
+	}
+	if got, want := buf.String(), ": 200 OK in "; !strings.Contains(got, want) {
+		t.Errorf("Logger output is %q, want to contain %q", got, want)
+	}
+}
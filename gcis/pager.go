@@ -0,0 +1,260 @@
+package gcis
+
+import (
+	"context"
+	"sync"
+)
+
+// pager holds the paging state shared by the pagers of search results.
+type pager struct {
+	opts SearchOptions
+	done bool
+}
+
+func newPager(opts SearchOptions) pager {
+	if opts.Top == 0 {
+		opts.Top = DefaultTop
+	}
+	return pager{opts: opts}
+}
+
+// More reports whether there may be more pages to fetch.
+func (p *pager) More() bool {
+	return !p.done
+}
+
+// Offset returns the offset of the next page, which can be used as SearchOptions.Skip to resume the search.
+func (p *pager) Offset() int {
+	return p.opts.Skip
+}
+
+// advance moves past the page of n records, a short page is the last one.
+func (p *pager) advance(n int) {
+	p.opts.Skip += n
+	if n < p.opts.Top {
+		p.done = true
+	}
+}
+
+// resume moves to the offset, to continue from a partially consumed page.
+func (p *pager) resume(offset int) {
+	p.opts.Skip = offset
+	p.done = false
+}
+
+// finish moves past the last n records fetched at once.
+func (p *pager) finish(n int) {
+	p.opts.Skip += n
+	p.done = true
+}
+
+// fetchPages fetches the pages from opts with the number of workers in parallel, fetch stores the page by its index
+// and returns the number of records. It stops at the first short page, or at the first error which is not past it,
+// and returns the number of pages to take in order.
+func fetchPages(ctx context.Context, opts SearchOptions, workers int, fetch func(ctx context.Context, page int, opts SearchOptions) (int, error)) (int, error) {
+	if workers < 1 {
+		workers = 1
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu        sync.Mutex
+		wg        sync.WaitGroup
+		next      int
+		last      = -1 // index of the first short page, -1 until found
+		errPage   = -1 // index of the first page failed, -1 until found
+		err       error
+		completed = make(map[int]bool)
+		prefix    int // pages before prefix are all completed
+		decided   bool
+	)
+	// bound returns the index of the page ending the fetch, or -1 if unknown yet.
+	bound := func() int {
+		if errPage >= 0 && (last < 0 || errPage < last) {
+			return errPage
+		}
+		return last
+	}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				mu.Lock()
+				if b := bound(); decided || (b >= 0 && next > b) {
+					mu.Unlock()
+					return
+				}
+				page := next
+				next++
+				mu.Unlock()
+
+				n, e := fetch(ctx, page, SearchOptions{Skip: opts.Skip + page*opts.Top, Top: opts.Top})
+
+				mu.Lock()
+				if e != nil {
+					if errPage < 0 || page < errPage {
+						errPage, err = page, e
+					}
+				} else if n < opts.Top && (last < 0 || page < last) {
+					last = page
+				}
+				completed[page] = true
+				for completed[prefix] {
+					delete(completed, prefix)
+					prefix++
+				}
+				// Once the pages before the bound are all completed the result is decided, requests still in
+				// flight are past the bound and canceled.
+				if b := bound(); b >= 0 && prefix > b {
+					decided = true
+					cancel()
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if errPage >= 0 && (last < 0 || errPage < last) {
+		return 0, err
+	}
+	return last + 1, nil
+}
+
+// CompanyByKeywordPager walks all pages of CompanyService.SearchByKeyword.
+type CompanyByKeywordPager struct {
+	pager
+	service *CompanyService
+	input   CompanyByKeywordInput
+}
+
+// SearchByKeywordPager returns the pager of SearchByKeyword starting from the SearchOptions of input.
+func (s *CompanyService) SearchByKeywordPager(input *CompanyByKeywordInput) *CompanyByKeywordPager {
+	return &CompanyByKeywordPager{
+		pager:   newPager(input.SearchOptions),
+		service: s,
+		input:   *input,
+	}
+}
+
+// Next fetches the next page, it returns nil when there are no more pages.
+func (p *CompanyByKeywordPager) Next(ctx context.Context) ([]CompanyByKeywordOutput, *Response, error) {
+	if !p.More() {
+		return nil, nil, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	input := p.input
+	input.SearchOptions = p.opts
+
+	outputs, resp, err := p.service.SearchByKeyword(ctx, &input)
+	if err != nil {
+		return nil, resp, err
+	}
+	p.advance(len(outputs))
+	return outputs, resp, nil
+}
+
+// FetchAll fetches all remaining pages with the number of workers in parallel, and returns the records in order.
+func (p *CompanyByKeywordPager) FetchAll(ctx context.Context, workers int) ([]CompanyByKeywordOutput, error) {
+	if !p.More() {
+		return nil, nil
+	}
+	var mu sync.Mutex
+	pages := make(map[int][]CompanyByKeywordOutput)
+
+	n, err := fetchPages(ctx, p.opts, workers, func(ctx context.Context, page int, opts SearchOptions) (int, error) {
+		input := p.input
+		input.SearchOptions = opts
+
+		outputs, _, err := p.service.SearchByKeyword(ctx, &input)
+		if err != nil {
+			return 0, err
+		}
+		mu.Lock()
+		pages[page] = outputs
+		mu.Unlock()
+		return len(outputs), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := []CompanyByKeywordOutput{}
+	for i := 0; i < n; i++ {
+		outputs = append(outputs, pages[i]...)
+	}
+	p.finish(len(outputs))
+	return outputs, nil
+}
+
+// CompanyByResponsibleNamePager walks all pages of CompanyService.SearchByResponsibleName.
+type CompanyByResponsibleNamePager struct {
+	pager
+	service *CompanyService
+	input   CompanyByResponsibleNameInput
+}
+
+// SearchByResponsibleNamePager returns the pager of SearchByResponsibleName starting from the SearchOptions of input.
+func (s *CompanyService) SearchByResponsibleNamePager(input *CompanyByResponsibleNameInput) *CompanyByResponsibleNamePager {
+	return &CompanyByResponsibleNamePager{
+		pager:   newPager(input.SearchOptions),
+		service: s,
+		input:   *input,
+	}
+}
+
+// Next fetches the next page, it returns nil when there are no more pages.
+func (p *CompanyByResponsibleNamePager) Next(ctx context.Context) ([]CompanyByResponsibleNameOutput, *Response, error) {
+	if !p.More() {
+		return nil, nil, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	input := p.input
+	input.SearchOptions = p.opts
+
+	outputs, resp, err := p.service.SearchByResponsibleName(ctx, &input)
+	if err != nil {
+		return nil, resp, err
+	}
+	p.advance(len(outputs))
+	return outputs, resp, nil
+}
+
+// FetchAll fetches all remaining pages with the number of workers in parallel, and returns the records in order.
+func (p *CompanyByResponsibleNamePager) FetchAll(ctx context.Context, workers int) ([]CompanyByResponsibleNameOutput, error) {
+	if !p.More() {
+		return nil, nil
+	}
+	var mu sync.Mutex
+	pages := make(map[int][]CompanyByResponsibleNameOutput)
+
+	n, err := fetchPages(ctx, p.opts, workers, func(ctx context.Context, page int, opts SearchOptions) (int, error) {
+		input := p.input
+		input.SearchOptions = opts
+
+		outputs, _, err := p.service.SearchByResponsibleName(ctx, &input)
+		if err != nil {
+			return 0, err
+		}
+		mu.Lock()
+		pages[page] = outputs
+		mu.Unlock()
+		return len(outputs), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := []CompanyByResponsibleNameOutput{}
+	for i := 0; i < n; i++ {
+		outputs = append(outputs, pages[i]...)
+	}
+	p.finish(len(outputs))
+	return outputs, nil
+}
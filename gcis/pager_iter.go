@@ -0,0 +1,51 @@
+//go:build go1.23
+// +build go1.23
+
+package gcis
+
+import (
+	"context"
+	"iter"
+)
+
+// All returns an iterator over the records of all remaining pages. When the loop stops early,
+// Offset points right after the last yielded record.
+func (p *CompanyByKeywordPager) All(ctx context.Context) iter.Seq2[CompanyByKeywordOutput, error] {
+	return func(yield func(CompanyByKeywordOutput, error) bool) {
+		for p.More() {
+			offset := p.Offset()
+			outputs, _, err := p.Next(ctx)
+			if err != nil {
+				yield(CompanyByKeywordOutput{}, err)
+				return
+			}
+			for i, output := range outputs {
+				if !yield(output, nil) {
+					p.resume(offset + i + 1)
+					return
+				}
+			}
+		}
+	}
+}
+
+// All returns an iterator over the records of all remaining pages. When the loop stops early,
+// Offset points right after the last yielded record.
+func (p *CompanyByResponsibleNamePager) All(ctx context.Context) iter.Seq2[CompanyByResponsibleNameOutput, error] {
+	return func(yield func(CompanyByResponsibleNameOutput, error) bool) {
+		for p.More() {
+			offset := p.Offset()
+			outputs, _, err := p.Next(ctx)
+			if err != nil {
+				yield(CompanyByResponsibleNameOutput{}, err)
+				return
+			}
+			for i, output := range outputs {
+				if !yield(output, nil) {
+					p.resume(offset + i + 1)
+					return
+				}
+			}
+		}
+	}
+}
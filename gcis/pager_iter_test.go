@@ -0,0 +1,72 @@
+//go:build go1.23
+// +build go1.23
+
+package gcis
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestCompanyByKeywordPager_All(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handlePages(t, "/od/data/api/6BBA2268-1367-4B42-9CCA-BC17499EBE8C", 5)
+
+	p := client.Company.SearchByKeywordPager(&CompanyByKeywordInput{SearchOptions: SearchOptions{Top: 2}})
+
+	var got []string
+	for output, err := range p.All(context.Background()) {
+		if err != nil {
+			t.Fatalf("CompanyByKeywordPager.All returned error: %v", err)
+		}
+		got = append(got, output.BusinessAccountingNO)
+	}
+	if want := []string{"00000000", "00000001", "00000002", "00000003", "00000004"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CompanyByKeywordPager.All = %v, want %v", got, want)
+	}
+}
+
+func TestCompanyByKeywordPager_All_break(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handlePages(t, "/od/data/api/6BBA2268-1367-4B42-9CCA-BC17499EBE8C", 5)
+
+	p := client.Company.SearchByKeywordPager(&CompanyByKeywordInput{SearchOptions: SearchOptions{Top: 2}})
+	for output := range p.All(context.Background()) {
+		if output.BusinessAccountingNO == "00000002" {
+			break
+		}
+	}
+	if got, want := p.Offset(), 3; got != want {
+		t.Errorf("CompanyByKeywordPager.Offset = %v, want %v", got, want)
+	}
+
+	var got []string
+	for output := range p.All(context.Background()) {
+		got = append(got, output.BusinessAccountingNO)
+	}
+	if want := []string{"00000003", "00000004"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CompanyByKeywordPager.All = %v, want %v", got, want)
+	}
+}
+
+func TestCompanyByResponsibleNamePager_All_canceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handlePages(t, "/od/data/api/4B61A0F1-458C-43F9-93F3-9FD6DA5E1B08", 5)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	p := client.Company.SearchByResponsibleNamePager(&CompanyByResponsibleNameInput{})
+	for _, err := range p.All(ctx) {
+		if err != context.Canceled {
+			t.Errorf("CompanyByResponsibleNamePager.All returned error: %v, want %v", err, context.Canceled)
+		}
+	}
+}
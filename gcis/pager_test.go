@@ -0,0 +1,259 @@
+package gcis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// handlePages serves total records split into pages by $skip and $top, recording the requested skips.
+func handlePages(t *testing.T, pattern string, total int) *[]int {
+	var mu sync.Mutex
+	skips := new([]int)
+	mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		skip, _ := strconv.Atoi(r.URL.Query().Get("$skip"))
+		top, _ := strconv.Atoi(r.URL.Query().Get("$top"))
+		mu.Lock()
+		*skips = append(*skips, skip)
+		mu.Unlock()
+
+		records := []map[string]string{}
+		for i := skip; i < skip+top && i < total; i++ {
+			records = append(records, map[string]string{"Business_Accounting_NO": fmt.Sprintf("%08d", i)})
+		}
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		json.NewEncoder(w).Encode(records)
+	})
+	return skips
+}
+
+func TestCompanyService_SearchByKeywordPager(t *testing.T) {
+	setup()
+	defer teardown()
+
+	skips := handlePages(t, "/od/data/api/6BBA2268-1367-4B42-9CCA-BC17499EBE8C", 5)
+
+	input := &CompanyByKeywordInput{CompanyName: "台積電", CompanyStatus: "01", SearchOptions: SearchOptions{Top: 2}}
+	p := client.Company.SearchByKeywordPager(input)
+
+	var got []string
+	for p.More() {
+		outputs, _, err := p.Next(context.Background())
+		if err != nil {
+			t.Fatalf("CompanyByKeywordPager.Next returned error: %v", err)
+		}
+		for _, output := range outputs {
+			got = append(got, output.BusinessAccountingNO)
+		}
+	}
+	if want := []string{"00000000", "00000001", "00000002", "00000003", "00000004"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CompanyByKeywordPager records = %v, want %v", got, want)
+	}
+	if want := []int{0, 2, 4}; !reflect.DeepEqual(*skips, want) {
+		t.Errorf("CompanyByKeywordPager skips = %v, want %v", *skips, want)
+	}
+	if got, want := p.Offset(), 5; got != want {
+		t.Errorf("CompanyByKeywordPager.Offset = %v, want %v", got, want)
+	}
+	if input.Skip != 0 {
+		t.Errorf("CompanyByKeywordPager modified input Skip = %v, want 0", input.Skip)
+	}
+
+	outputs, _, err := p.Next(context.Background())
+	if outputs != nil || err != nil {
+		t.Errorf("CompanyByKeywordPager.Next = %v, %v, want nil, nil", outputs, err)
+	}
+}
+
+func TestCompanyService_SearchByKeywordPager_resume(t *testing.T) {
+	setup()
+	defer teardown()
+
+	skips := handlePages(t, "/od/data/api/6BBA2268-1367-4B42-9CCA-BC17499EBE8C", 100)
+
+	p := client.Company.SearchByKeywordPager(&CompanyByKeywordInput{SearchOptions: SearchOptions{Skip: 40, Top: 30}})
+	if _, _, err := p.Next(context.Background()); err != nil {
+		t.Fatalf("CompanyByKeywordPager.Next returned error: %v", err)
+	}
+	if want := []int{40}; !reflect.DeepEqual(*skips, want) {
+		t.Errorf("CompanyByKeywordPager skips = %v, want %v", *skips, want)
+	}
+	if got, want := p.Offset(), 70; got != want {
+		t.Errorf("CompanyByKeywordPager.Offset = %v, want %v", got, want)
+	}
+}
+
+func TestCompanyService_SearchByKeywordPager_canceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	skips := handlePages(t, "/od/data/api/6BBA2268-1367-4B42-9CCA-BC17499EBE8C", 5)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	p := client.Company.SearchByKeywordPager(&CompanyByKeywordInput{})
+	if _, _, err := p.Next(ctx); err != context.Canceled {
+		t.Errorf("CompanyByKeywordPager.Next returned error: %v, want %v", err, context.Canceled)
+	}
+	if len(*skips) != 0 {
+		t.Errorf("CompanyByKeywordPager made %v requests, want 0", len(*skips))
+	}
+}
+
+func TestCompanyService_SearchByResponsibleNamePager(t *testing.T) {
+	setup()
+	defer teardown()
+
+	skips := handlePages(t, "/od/data/api/4B61A0F1-458C-43F9-93F3-9FD6DA5E1B08", 4)
+
+	p := client.Company.SearchByResponsibleNamePager(&CompanyByResponsibleNameInput{ResponsibleName: "劉德音", SearchOptions: SearchOptions{Top: 2}})
+
+	n := 0
+	for p.More() {
+		outputs, _, err := p.Next(context.Background())
+		if err != nil {
+			t.Fatalf("CompanyByResponsibleNamePager.Next returned error: %v", err)
+		}
+		n += len(outputs)
+	}
+	if n != 4 {
+		t.Errorf("CompanyByResponsibleNamePager returned %v records, want 4", n)
+	}
+	// The last page is empty since the previous one is full.
+	if want := []int{0, 2, 4}; !reflect.DeepEqual(*skips, want) {
+		t.Errorf("CompanyByResponsibleNamePager skips = %v, want %v", *skips, want)
+	}
+}
+
+func TestCompanyByKeywordPager_FetchAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handlePages(t, "/od/data/api/6BBA2268-1367-4B42-9CCA-BC17499EBE8C", 23)
+
+	p := client.Company.SearchByKeywordPager(&CompanyByKeywordInput{SearchOptions: SearchOptions{Skip: 1, Top: 5}})
+	outputs, err := p.FetchAll(context.Background(), 3)
+	if err != nil {
+		t.Fatalf("CompanyByKeywordPager.FetchAll returned error: %v", err)
+	}
+
+	var got, want []string
+	for _, output := range outputs {
+		got = append(got, output.BusinessAccountingNO)
+	}
+	for i := 1; i < 23; i++ {
+		want = append(want, fmt.Sprintf("%08d", i))
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CompanyByKeywordPager.FetchAll = %v, want %v", got, want)
+	}
+	if p.More() {
+		t.Errorf("CompanyByKeywordPager.More = true, want false")
+	}
+	if got, want := p.Offset(), 23; got != want {
+		t.Errorf("CompanyByKeywordPager.Offset = %v, want %v", got, want)
+	}
+}
+
+func TestCompanyByResponsibleNamePager_FetchAll_error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// The server never returns a short page, FetchAll returns only when workers stop on the error.
+	mux.HandleFunc("/od/data/api/4B61A0F1-458C-43F9-93F3-9FD6DA5E1B08", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("$skip") == "4" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		w.Write([]byte(`[{}, {}]`))
+	})
+
+	p := client.Company.SearchByResponsibleNamePager(&CompanyByResponsibleNameInput{SearchOptions: SearchOptions{Top: 2}})
+	outputs, err := p.FetchAll(context.Background(), 4)
+	if err == nil {
+		t.Errorf("CompanyByResponsibleNamePager.FetchAll expected error")
+	}
+	if outputs != nil {
+		t.Errorf("CompanyByResponsibleNamePager.FetchAll = %v, want nil", outputs)
+	}
+	if got, want := p.Offset(), 0; got != want {
+		t.Errorf("CompanyByResponsibleNamePager.Offset = %v, want %v", got, want)
+	}
+}
+
+func TestFetchPages_errorPastShortPage(t *testing.T) {
+	// The page past the short one fails first, while the pages before it are still in flight.
+	failed := make(chan struct{})
+	n, err := fetchPages(context.Background(), SearchOptions{Top: 2}, 4, func(ctx context.Context, page int, opts SearchOptions) (int, error) {
+		if page == 3 {
+			close(failed)
+			return 0, errors.New("page past the end")
+		}
+		select {
+		case <-failed:
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+		if page == 2 {
+			return 1, nil
+		}
+		return 2, nil
+	})
+	if err != nil {
+		t.Fatalf("fetchPages returned error: %v", err)
+	}
+	if want := 3; n != want {
+		t.Errorf("fetchPages = %v, want %v", n, want)
+	}
+}
+
+func TestFetchPages_error(t *testing.T) {
+	want := errors.New("page failed")
+	_, err := fetchPages(context.Background(), SearchOptions{Top: 2}, 4, func(ctx context.Context, page int, opts SearchOptions) (int, error) {
+		switch page {
+		case 1:
+			return 0, want
+		case 5:
+			return 1, nil
+		}
+		return 2, nil
+	})
+	if err != want {
+		t.Errorf("fetchPages returned error: %v, want %v", err, want)
+	}
+}
+
+func TestCompanyByKeywordPager_emptyChunked(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handleChunked(t, "/od/data/api/6BBA2268-1367-4B42-9CCA-BC17499EBE8C", nil)
+
+	p := client.Company.SearchByKeywordPager(&CompanyByKeywordInput{})
+	got, _, err := p.Next(context.Background())
+	if err != nil {
+		t.Errorf("CompanyByKeywordPager.Next returned error: %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("CompanyByKeywordPager.Next = %+v, want empty", got)
+	}
+
+	p = client.Company.SearchByKeywordPager(&CompanyByKeywordInput{})
+	all, err := p.FetchAll(context.Background(), 2)
+	if err != nil {
+		t.Errorf("CompanyByKeywordPager.FetchAll returned error: %v", err)
+	}
+	if len(all) != 0 {
+		t.Errorf("CompanyByKeywordPager.FetchAll = %+v, want empty", all)
+	}
+}
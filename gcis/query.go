@@ -0,0 +1,124 @@
+package gcis
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Filter is a $filter expression of the GCIS API, built with Eq, Like, Ge, Le, And and Or.
+// Values are quoted as OData string literals, the zero Filter matches everything and is left out of the query.
+type Filter struct {
+	expr string
+	or   bool
+}
+
+// String returns the expression of filter.
+func (f Filter) String() string {
+	return f.expr
+}
+
+// Eq returns the filter "field eq 'value'".
+func Eq(field, value string) Filter {
+	return Filter{expr: field + " eq " + literal(value)}
+}
+
+// Like returns the filter "field like 'value'".
+func Like(field, value string) Filter {
+	return Filter{expr: field + " like " + literal(value)}
+}
+
+// Ge returns the filter "field ge 'value'".
+func Ge(field, value string) Filter {
+	return Filter{expr: field + " ge " + literal(value)}
+}
+
+// Le returns the filter "field le 'value'".
+func Le(field, value string) Filter {
+	return Filter{expr: field + " le " + literal(value)}
+}
+
+// literal quotes s as OData string literal, so that the value can never change the filter expression.
+func literal(s string) string {
+	return "'" + strings.Replace(s, "'", "''", -1) + "'"
+}
+
+// And combines the non-zero filters with "and".
+func And(filters ...Filter) Filter {
+	filters = nonZero(filters)
+	if len(filters) <= 1 {
+		return first(filters)
+	}
+	exprs := make([]string, len(filters))
+	for i, f := range filters {
+		exprs[i] = f.expr
+		if f.or {
+			exprs[i] = "(" + f.expr + ")"
+		}
+	}
+	return Filter{expr: strings.Join(exprs, " and ")}
+}
+
+// Or combines the non-zero filters with "or".
+func Or(filters ...Filter) Filter {
+	filters = nonZero(filters)
+	if len(filters) <= 1 {
+		return first(filters)
+	}
+	exprs := make([]string, len(filters))
+	for i, f := range filters {
+		exprs[i] = f.expr
+	}
+	return Filter{expr: strings.Join(exprs, " or "), or: true}
+}
+
+func nonZero(filters []Filter) []Filter {
+	var fs []Filter
+	for _, f := range filters {
+		if f.expr != "" {
+			fs = append(fs, f)
+		}
+	}
+	return fs
+}
+
+func first(filters []Filter) Filter {
+	if len(filters) == 0 {
+		return Filter{}
+	}
+	return filters[0]
+}
+
+// Query is a query of GCIS dataset.
+type Query struct {
+	Filter Filter
+	// Skip is sent when Skip or Top is greater than zero, Top only when it is greater than zero.
+	Skip int
+	Top  int
+	// Format defaults to Client.Format.
+	Format string
+}
+
+// Encode encodes the query into URL query string.
+func (q *Query) Encode() string {
+	format := q.Format
+	if format == "" {
+		format = FormatJSON
+	}
+	params := []string{"$format=" + escape(format)}
+	if q.Filter.expr != "" {
+		params = append(params, "$filter="+escape(q.Filter.expr))
+	}
+	if q.Skip > 0 || q.Top > 0 {
+		params = append(params, "$skip="+strconv.Itoa(q.Skip))
+	}
+	if q.Top > 0 {
+		params = append(params, "$top="+strconv.Itoa(q.Top))
+	}
+	return strings.Join(params, "&")
+}
+
+// escape escapes s for use as URL query value, encoding spaces as %20 as GCIS API expects.
+func escape(s string) string {
+	return strings.Replace(url.QueryEscape(s), "+", "%20", -1)
+}
@@ -0,0 +1,77 @@
+package gcis
+
+import "testing"
+
+func TestFilter(t *testing.T) {
+	tests := []struct {
+		filter Filter
+		want   string
+	}{
+		{Eq("Company_Status", "01"), "Company_Status eq '01'"},
+		{Like("Company_Name", "台積電"), "Company_Name like '台積電'"},
+		{Ge("Company_Setup_Date", "1080101"), "Company_Setup_Date ge '1080101'"},
+		{Le("Company_Setup_Date", "1081231"), "Company_Setup_Date le '1081231'"},
+		{And(), ""},
+		{And(Filter{}, Eq("Agency", "376610000A")), "Agency eq '376610000A'"},
+		{And(Like("Business_Name", "鼎勝"), Eq("Agency", "376610000A")), "Business_Name like '鼎勝' and Agency eq '376610000A'"},
+		{Or(Eq("President_No", "1"), Filter{}, Eq("President_No", "2")), "President_No eq '1' or President_No eq '2'"},
+		{
+			And(Eq("Company_Status", "01"), Or(Eq("Register_Organization", "03"), Eq("Register_Organization", "04"))),
+			"Company_Status eq '01' and (Register_Organization eq '03' or Register_Organization eq '04')",
+		},
+		{And(Or(Eq("Agency", "1"), Eq("Agency", "2"))), "Agency eq '1' or Agency eq '2'"},
+	}
+
+	for i, test := range tests {
+		if got := test.filter.String(); got != test.want {
+			t.Errorf("(%v) Filter = %v, want %v", i, got, test.want)
+		}
+	}
+}
+
+func TestFilter_literal(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"O'Reilly", "Company_Name like 'O''Reilly'"},
+		{"x' or Company_Status eq '03", "Company_Name like 'x'' or Company_Status eq ''03'"},
+		{"''", "Company_Name like ''''''"},
+		{"", "Company_Name like ''"},
+	}
+
+	for i, test := range tests {
+		if got := Like("Company_Name", test.value).String(); got != test.want {
+			t.Errorf("(%v) Filter = %v, want %v", i, got, test.want)
+		}
+	}
+}
+
+func TestQuery_Encode(t *testing.T) {
+	tests := []struct {
+		query *Query
+		want  string
+	}{
+		{&Query{}, "$format=json"},
+		{&Query{Format: "xml"}, "$format=xml"},
+		{&Query{Skip: 100}, "$format=json&$skip=100"},
+		{
+			&Query{Filter: Eq("Business_Accounting_NO", "20828393")},
+			"$format=json&$filter=Business_Accounting_NO%20eq%20%2720828393%27",
+		},
+		{
+			&Query{Filter: Like("Company_Name", "台積電"), Skip: 10, Top: 50},
+			"$format=json&$filter=Company_Name%20like%20%27%E5%8F%B0%E7%A9%8D%E9%9B%BB%27&$skip=10&$top=50",
+		},
+		{
+			&Query{Filter: Like("Company_Name", "A&B"), Top: 1},
+			"$format=json&$filter=Company_Name%20like%20%27A%26B%27&$skip=0&$top=1",
+		},
+	}
+
+	for i, test := range tests {
+		if got := test.query.Encode(); got != test.want {
+			t.Errorf("(%v) Query.Encode = %v, want %v", i, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package gcis
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_wait(t *testing.T) {
+	l := &rateLimiter{interval: time.Hour}
+
+	if err := l.wait(context.Background()); err != nil {
+		t.Errorf("rateLimiter.wait returned error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := l.wait(ctx); err != context.Canceled {
+		t.Errorf("rateLimiter.wait returned error: %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,77 @@
+package gcis
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// ErrStopStream can be returned by the callback of streaming APIs to stop the stream without error.
+var ErrStopStream = errors.New("stop stream")
+
+// recordStream decodes the records of response one at a time into v, calling fn after each one,
+// instead of decoding the whole response at once. It is handled by Client.Do.
+type recordStream struct {
+	v  interface{}
+	fn func() error
+}
+
+func newRecordStream(v interface{}, fn func() error) *recordStream {
+	return &recordStream{v: v, fn: fn}
+}
+
+// next zeroes v, so that fields missing in the record are not left over from the previous one,
+// and decodes the record.
+func (s *recordStream) next(decode func(v interface{}) error) error {
+	rv := reflect.ValueOf(s.v).Elem()
+	rv.Set(reflect.Zero(rv.Type()))
+	if err := decode(s.v); err != nil {
+		return err
+	}
+	return s.fn()
+}
+
+func (s *recordStream) decode(r io.Reader, isXML bool) error {
+	var err error
+	if isXML {
+		err = s.decodeXML(r)
+	} else {
+		err = s.decodeJSON(r)
+	}
+	if err == ErrStopStream {
+		err = nil
+	}
+	return err
+}
+
+func (s *recordStream) decodeJSON(r io.Reader) error {
+	dec := json.NewDecoder(r)
+	tok, err := dec.Token()
+	if err == io.EOF {
+		return nil // empty response body
+	}
+	if err != nil {
+		return err
+	}
+	if tok != json.Delim('[') {
+		return fmt.Errorf("unexpected JSON token %v, want [", tok)
+	}
+	for dec.More() {
+		if err := s.next(dec.Decode); err != nil {
+			return err
+		}
+	}
+	_, err = dec.Token()
+	return err
+}
+
+func (s *recordStream) decodeXML(r io.Reader) error {
+	return eachXMLElement(r, func(dec *xml.Decoder, start *xml.StartElement) error {
+		return s.next(func(v interface{}) error {
+			return dec.DecodeElement(v, start)
+		})
+	})
+}